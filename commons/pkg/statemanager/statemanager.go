@@ -77,6 +77,8 @@
 //
 //	Entry:
 //	  none → quarantined           (fault-quarantine detects fault)
+//	  none → warning               (fault-quarantine records a non-fatal fault without cordoning)
+//	  warning → quarantined        (fault-quarantine escalates on a fatal fault)
 //
 //	Drain Phase:
 //	  quarantined → draining       (node-drainer starts drain)
@@ -163,6 +165,7 @@ type NVSentinelStateLabelValue string
 
 const (
 	// Label values applied by the fault-quarantine:
+	WarningLabelValue     NVSentinelStateLabelValue = "warning"
 	QuarantinedLabelValue NVSentinelStateLabelValue = "quarantined"
 
 	// Label values applied by the node-drainer:
@@ -306,9 +309,9 @@ func validateStateTransition(nodeName, currentValue string, exists bool, targetS
 		fromState = currentValue
 	}
 
-	// If no label exists, only Quarantined is the expected first state
+	// If no label exists, only Warning or Quarantined are expected first states
 	if !exists {
-		if targetState != QuarantinedLabelValue {
+		if targetState != QuarantinedLabelValue && targetState != WarningLabelValue {
 			stateTransitionUnexpected.WithLabelValues(fromState, string(targetState), nodeName).Inc()
 
 			return fmt.Errorf("unexpected state transition: %s -> %s (expected first state: %s)",
@@ -320,6 +323,7 @@ func validateStateTransition(nodeName, currentValue string, exists bool, targetS
 
 	// Define expected transitions based on the normal state machine flow
 	validTransitions := map[NVSentinelStateLabelValue][]NVSentinelStateLabelValue{
+		WarningLabelValue:              {QuarantinedLabelValue},
 		QuarantinedLabelValue:          {DrainingLabelValue, DrainSucceededLabelValue},
 		DrainingLabelValue:             {DrainSucceededLabelValue, DrainFailedLabelValue},
 		DrainSucceededLabelValue:       {RemediatingLabelValue},
@@ -218,6 +218,8 @@ func TestStateTransitionValidProgression(t *testing.T) {
 		{"Remediating to RemediationSucceeded", string(RemediatingLabelValue), RemediationSucceededLabelValue, true, false},
 		{"Remediating to RemediationFailed", string(RemediatingLabelValue), RemediationFailedLabelValue, true, false},
 		{"Quarantined to DrainSucceeded", string(QuarantinedLabelValue), DrainSucceededLabelValue, true, false},
+		{"NoState to Warning", "", WarningLabelValue, false, false},
+		{"Warning to Quarantined", string(WarningLabelValue), QuarantinedLabelValue, true, false},
 
 		// Unexpected progressions (return error but label is still updated)
 		// This allows callers to emit error metrics while labels reflect reality
@@ -229,6 +231,7 @@ func TestStateTransitionValidProgression(t *testing.T) {
 		{"DrainFailed to Remediating", string(DrainFailedLabelValue), RemediatingLabelValue, true, true},
 		{"DrainSucceeded to DrainFailed", string(DrainSucceededLabelValue), DrainFailedLabelValue, true, true},
 		{"RemediationSucceeded to Draining", string(RemediationSucceededLabelValue), DrainingLabelValue, true, true},
+		{"Warning to Draining", string(WarningLabelValue), DrainingLabelValue, true, true},
 	}
 
 	for _, tt := range tests {
@@ -35,6 +35,9 @@ const (
 	Quarantined        Status = "Quarantined"
 	AlreadyQuarantined Status = "AlreadyQuarantined"
 	Cancelled          Status = "Cancelled"
+	// ClusterScoped marks an event that names no node. It is routed to alerting only and is
+	// never cordoned, drained or remediated.
+	ClusterScoped Status = "ClusterScoped"
 )
 
 type HealthEventWithStatus struct {
@@ -125,6 +125,10 @@ spec:
             {{- end }}
             - "--checks"
             - "{{ join "," $root.Values.enabledChecks }}"
+            {{- with $root.Values.confirmationWindows }}
+            - "--confirmation-windows"
+            - "{{ range $check, $window := . }}{{ $check }}={{ $window }},{{ end }}"
+            {{- end }}
             - "--metadata-path"
             - "{{ $root.Values.global.metadataPath }}"
             - "--processing-strategy"
@@ -32,6 +32,18 @@ const (
 	QuarantinedNodeUncordonedManuallyAnnotationValue   = "True"
 	QuarantinedNodeIsUntaintedManuallyAnnotationKey    = "quarantinedNodeUntaintedManually"
 	QuarantinedNodeIsUntaintedManuallyAnnotationValue  = "True"
+	QuarantinedNodeUncordonApprovedAnnotationKey       = "quarantinedNodeUncordonApproved"
+	QuarantinedNodeUncordonApprovedAnnotationValue     = "True"
+	WarningHealthEventsAnnotationKey                   = "warningHealthEvents"
+	// QuarantineDeferredHealthEventAnnotationKey holds the health event whose quarantine was
+	// deferred because the quarantine budget was exhausted
+	QuarantineDeferredHealthEventAnnotationKey = "quarantineDeferredHealthEvent"
+	// PostRemediationVerificationPendingAnnotationKey holds the healthy event whose uncordon is
+	// waiting for post-remediation verification to pass
+	PostRemediationVerificationPendingAnnotationKey = "postRemediationVerificationPendingHealthEvent"
+
+	// AwaitingUncordonConditionType is set on a recovered node that waits for operator approval to be uncordoned
+	AwaitingUncordonConditionType = "AwaitingUncordon"
 
 	ServiceName = "NVSentinel"
 )
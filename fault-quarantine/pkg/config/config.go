@@ -34,6 +34,46 @@ type CircuitBreaker struct {
 	Duration   string `toml:"duration"`
 }
 
+// QuarantineBudget caps how many nodes may be quarantined at the same time. Once the budget
+// is spent, new quarantines are deferred so that a misfiring monitor cannot cordon the whole
+// cluster. Zero values disable the corresponding limit.
+type QuarantineBudget struct {
+	MaxNodes      int `toml:"maxNodes"`
+	MaxPercentage int `toml:"maxPercentage"`
+}
+
+// WarningPhase records unhealthy, non-fatal events that match no quarantine rule as a warning
+// on the node without cordoning or tainting it. A later fatal event escalates the node to
+// quarantine through the normal rule evaluation.
+type WarningPhase struct {
+	Enabled bool `toml:"enabled"`
+}
+
+// ClusterScopedEvents controls health events that carry no node name, such as cluster-wide
+// faults. Such events never cordon, taint or drain a node. When enabled they are recorded with
+// the ClusterScoped status and raised as an alert; otherwise they are skipped.
+type ClusterScopedEvents struct {
+	Enabled bool `toml:"enabled"`
+}
+
+// PostRemediationVerification gates the uncordon of a node that has been remediated.
+// When enabled, a remediated node is only released once every listed node condition
+// reports healthy (status False) with a heartbeat newer than the quarantine itself.
+type PostRemediationVerification struct {
+	Enabled                   bool     `toml:"enabled"`
+	RequiredHealthyConditions []string `toml:"requiredHealthyConditions"`
+}
+
+// UncordonPolicy controls what happens once every health check on a quarantined node has recovered.
+type UncordonPolicy string
+
+const (
+	// UncordonPolicyAutomatic uncordons the node as soon as all checks recover.
+	UncordonPolicyAutomatic UncordonPolicy = "Automatic"
+	// UncordonPolicyManual keeps the node cordoned until an operator approves the uncordon.
+	UncordonPolicyManual UncordonPolicy = "Manual"
+)
+
 type Match struct {
 	Any []Rule `toml:"any"`
 	All []Rule `toml:"all"`
@@ -50,7 +90,13 @@ type RuleSet struct {
 }
 
 type TomlConfig struct {
-	LabelPrefix    string         `toml:"label-prefix"`
-	CircuitBreaker CircuitBreaker `toml:"circuitBreaker"`
-	RuleSets       []RuleSet      `toml:"rule-sets"`
+	LabelPrefix                 string                      `toml:"label-prefix"`
+	CordonLabelKey              string                      `toml:"cordonLabelKey"`
+	CircuitBreaker              CircuitBreaker              `toml:"circuitBreaker"`
+	QuarantineBudget            QuarantineBudget            `toml:"quarantineBudget"`
+	WarningPhase                WarningPhase                `toml:"warningPhase"`
+	ClusterScopedEvents         ClusterScopedEvents         `toml:"clusterScopedEvents"`
+	PostRemediationVerification PostRemediationVerification `toml:"postRemediationVerification"`
+	UncordonPolicy              UncordonPolicy              `toml:"uncordonPolicy"`
+	RuleSets                    []RuleSet                   `toml:"rule-sets"`
 }
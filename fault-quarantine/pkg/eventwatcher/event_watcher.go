@@ -51,6 +51,7 @@ type EventWatcherInterface interface {
 	SetProcessEventCallback(callback func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status)
 	SetFetchDocIDsFn(fn func(ctx context.Context, nodeName string) []string)
 	CancelLatestQuarantiningEvents(ctx context.Context, nodeName string, reason string) error
+	UpdateNodeQuarantineStatus(ctx context.Context, eventID string, nodeQuarantinedStatus *model.Status) error
 }
 
 func NewEventWatcher(
@@ -198,14 +199,15 @@ func (w *EventWatcher) processEvent(ctx context.Context, event client.Event) err
 
 	var sourceDocIDs []string
 
-	if healthEventWithStatus.HealthEvent.GetIsHealthy() && w.fetchDocIDsFn != nil {
+	if healthEventWithStatus.HealthEvent.GetIsHealthy() && healthEventWithStatus.HealthEvent.GetNodeName() != "" &&
+		w.fetchDocIDsFn != nil {
 		sourceDocIDs = w.fetchDocIDsFn(ctx, healthEventWithStatus.HealthEvent.GetNodeName())
 	}
 
 	status := w.processEventCallback(ctx, &healthEventWithStatus)
 
 	if status != nil {
-		if err := w.updateNodeQuarantineStatus(ctx, recordUUID, status); err != nil {
+		if err := w.UpdateNodeQuarantineStatus(ctx, recordUUID, status); err != nil {
 			metrics.ProcessingErrors.WithLabelValues("update_quarantine_status_error").Inc()
 			slog.ErrorContext(ctx, "Failed to update node quarantine status", "error", err)
 
@@ -391,7 +393,8 @@ func (w *EventWatcher) updateUnprocessedEventsMetric(ctx context.Context) {
 	}
 }
 
-func (w *EventWatcher) updateNodeQuarantineStatus(
+// UpdateNodeQuarantineStatus records the quarantine status of the health event with the given record ID.
+func (w *EventWatcher) UpdateNodeQuarantineStatus(
 	ctx context.Context,
 	eventID string,
 	nodeQuarantinedStatus *model.Status,
@@ -49,6 +49,7 @@ type FaultQuarantineClient struct {
 	NodeInformer             *NodeInformer
 	cordonedReasonLabelKey   string
 	uncordonedReasonLabelKey string
+	cordonLabelKey           string
 	operationMutex           sync.Map // map[string]*sync.Mutex for per-node locking
 }
 
@@ -135,6 +136,12 @@ func (c *FaultQuarantineClient) SetLabelKeys(cordonedReasonKey, uncordonedReason
 	c.uncordonedReasonLabelKey = uncordonedReasonKey
 }
 
+// SetCordonLabelKey sets the label that marks nodes cordoned by NVSentinel. When set, the label is
+// only written if NVSentinel performed the cordon itself, and nodes without it are never uncordoned.
+func (c *FaultQuarantineClient) SetCordonLabelKey(cordonLabelKey string) {
+	c.cordonLabelKey = cordonLabelKey
+}
+
 func (c *FaultQuarantineClient) UpdateNode(ctx context.Context, nodeName string, updateFn func(*v1.Node) error) error {
 	mu, _ := c.operationMutex.LoadOrStore(nodeName, &sync.Mutex{})
 	mu.(*sync.Mutex).Lock()
@@ -171,6 +178,47 @@ func (c *FaultQuarantineClient) UpdateNode(ctx context.Context, nodeName string,
 	})
 }
 
+// UpdateNodeStatus applies updateFn to the latest version of the node and writes back its status subresource.
+func (c *FaultQuarantineClient) UpdateNodeStatus(ctx context.Context, nodeName string,
+	updateFn func(*v1.Node) error) error {
+	mu, _ := c.operationMutex.LoadOrStore(nodeName, &sync.Mutex{})
+	mu.(*sync.Mutex).Lock()
+
+	defer mu.(*sync.Mutex).Unlock()
+
+	if c.DryRunMode {
+		slog.InfoContext(ctx, "DryRun mode enabled, skipping node status update", "node", nodeName)
+		return nil
+	}
+
+	backoff := wait.Backoff{
+		Steps:    10,
+		Duration: 20 * time.Millisecond,
+		Factor:   2.0,
+		Jitter:   0.1,
+	}
+
+	return retry.OnError(backoff, isRetryableError, func() error {
+		node, err := c.Clientset.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if err := updateFn(node); err != nil {
+			return err
+		}
+
+		_, err = c.Clientset.CoreV1().Nodes().UpdateStatus(ctx, node, metav1.UpdateOptions{})
+		if err != nil {
+			return err
+		}
+
+		slog.Debug("Updated node status", "node", nodeName)
+
+		return nil
+	})
+}
+
 func isRetryableError(err error) bool {
 	if errors.IsConflict(err) {
 		return true
@@ -294,18 +342,26 @@ func (c *FaultQuarantineClient) QuarantineNodeAndSetAnnotations(
 			}
 		}
 
+		labelsToApply := labels
+
 		if isCordon {
+			alreadyCordoned := node.Spec.Unschedulable
+
 			if shouldSkip := c.handleCordon(ctx, node, nodename); shouldSkip {
 				return nil
 			}
+
+			if alreadyCordoned {
+				labelsToApply = c.withoutCordonLabel(labels)
+			}
 		}
 
 		if len(annotations) > 0 {
 			c.applyAnnotations(ctx, node, annotations, nodename)
 		}
 
-		if len(labels) > 0 {
-			c.applyLabels(ctx, node, labels, nodename)
+		if len(labelsToApply) > 0 {
+			c.applyLabels(ctx, node, labelsToApply, nodename)
 		}
 
 		return nil
@@ -314,6 +370,24 @@ func (c *FaultQuarantineClient) QuarantineNodeAndSetAnnotations(
 	return c.UpdateNode(ctx, nodename, updateFn)
 }
 
+// withoutCordonLabel returns labels without the cordon label, so a node that was already cordoned
+// by someone else is not marked as cordoned by NVSentinel.
+func (c *FaultQuarantineClient) withoutCordonLabel(labels map[string]string) map[string]string {
+	if _, ok := labels[c.cordonLabelKey]; c.cordonLabelKey == "" || !ok {
+		return labels
+	}
+
+	filtered := make(map[string]string, len(labels))
+
+	for k, v := range labels {
+		if k != c.cordonLabelKey {
+			filtered[k] = v
+		}
+	}
+
+	return filtered
+}
+
 func (c *FaultQuarantineClient) applyTaints(
 	ctx context.Context, node *v1.Node, taints []config.Taint, nodename string,
 ) error {
@@ -478,6 +552,13 @@ func (c *FaultQuarantineClient) removeTaints(
 func (c *FaultQuarantineClient) handleUncordon(
 	ctx context.Context, node *v1.Node, labels map[string]string, nodename string,
 ) {
+	if c.cordonLabelKey != "" && node.Labels[c.cordonLabelKey] == "" {
+		slog.InfoContext(ctx, "Node was not cordoned by NVSentinel; leaving it cordoned",
+			"node", nodename, "cordonLabelKey", c.cordonLabelKey)
+
+		return
+	}
+
 	slog.InfoContext(ctx, "Uncordoning node", "node", nodename)
 
 	if !c.DryRunMode {
@@ -33,6 +33,7 @@ type K8sClientInterface interface {
 	HandleManualUncordonCleanup(ctx context.Context, nodeName string, taintsToRemove []config.Taint,
 		annotationsToRemove []string, annotationsToAdd map[string]string, labelsToRemove []string) error
 	UpdateNode(ctx context.Context, nodeName string, updateFn func(*v1.Node) error) error
+	UpdateNodeStatus(ctx context.Context, nodeName string, updateFn func(*v1.Node) error) error
 	EnsureCircuitBreakerConfigMap(ctx context.Context, name, namespace string, initialStatus breaker.State) error
 	ReadCircuitBreakerState(ctx context.Context, name, namespace string) (breaker.State, error)
 	WriteCircuitBreakerState(ctx context.Context, name, namespace string, state breaker.State) error
@@ -51,6 +51,9 @@ type NodeInformer struct {
 
 	// onManualUntaint is called when a node is manually untainted while having FQ annotations
 	onManualUntaint func(nodeName string) error
+
+	// onUncordonApproved is called when an operator approves the uncordon of a node awaiting it
+	onUncordonApproved func(nodeName string) error
 }
 
 // Lister returns the informer's node lister.
@@ -384,11 +387,35 @@ func (ni *NodeInformer) detectAndHandleManualUntaint(oldNode, newNode *v1.Node)
 	return true
 }
 
-// handleUpdateNode detects and handles manual uncordon and manual untaint of quarantined nodes.
+// detectAndHandleUncordonApproval checks if an operator approved the uncordon of a node and handles it
+func (ni *NodeInformer) detectAndHandleUncordonApproval(oldNode, newNode *v1.Node) bool {
+	approved := common.QuarantinedNodeUncordonApprovedAnnotationValue
+
+	if oldNode.Annotations[common.QuarantinedNodeUncordonApprovedAnnotationKey] == approved ||
+		newNode.Annotations[common.QuarantinedNodeUncordonApprovedAnnotationKey] != approved {
+		return false
+	}
+
+	slog.Info("Detected uncordon approval of FQ-quarantined node", "node", newNode.Name)
+
+	if ni.onUncordonApproved == nil {
+		slog.Warn("Uncordon approval callback is not registered", "node", newNode.Name)
+		return false
+	}
+
+	if err := ni.onUncordonApproved(newNode.Name); err != nil {
+		slog.Error("Uncordon approval callback failed", "node", newNode.Name, "error", err)
+	}
+
+	return true
+}
+
+// handleUpdateNode detects and handles manual uncordon, manual untaint and uncordon approval of quarantined nodes.
 func (ni *NodeInformer) handleUpdateNode(oldNode, newNode *v1.Node) {
 	// Check manual uncordon first - if it triggers, it does full cleanup including taints
 	ni.detectAndHandleManualUncordon(oldNode, newNode)
 	ni.detectAndHandleManualUntaint(oldNode, newNode)
+	ni.detectAndHandleUncordonApproval(oldNode, newNode)
 }
 
 // SetOnQuarantinedNodeDeletedCallback sets the callback function for when a quarantined node is deleted
@@ -406,6 +433,11 @@ func (ni *NodeInformer) SetOnManualUntaintCallback(callback func(nodeName string
 	ni.onManualUntaint = callback
 }
 
+// SetOnUncordonApprovedCallback sets the callback function for when an operator approves a pending uncordon
+func (ni *NodeInformer) SetOnUncordonApprovedCallback(callback func(nodeName string) error) {
+	ni.onUncordonApproved = callback
+}
+
 // handleDeleteNode handles node deletion events.
 func (ni *NodeInformer) handleDeleteNode(obj interface{}) {
 	node, ok := obj.(*v1.Node)
@@ -77,6 +77,20 @@ var (
 		},
 		[]string{"node"},
 	)
+	PostRemediationVerifications = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_quarantine_post_remediation_verifications_total",
+			Help: "Total number of post-remediation health verifications performed before uncordon, by result.",
+		},
+		[]string{"result"},
+	)
+	NodesAwaitingUncordon = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "fault_quarantine_nodes_awaiting_uncordon",
+			Help: "Recovered nodes which are kept cordoned until an operator approves the uncordon",
+		},
+		[]string{"node"},
+	)
 	CurrentQuarantinedNodes = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "fault_quarantine_current_quarantined_nodes",
@@ -85,6 +99,28 @@ var (
 		[]string{"node"},
 	)
 
+	CurrentWarningNodes = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "fault_quarantine_current_warning_nodes",
+			Help: "Nodes which are degraded but still schedulable because only non-fatal events were reported",
+		},
+		[]string{"node"},
+	)
+	ClusterScopedEvents = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_quarantine_cluster_scoped_events_total",
+			Help: "Total number of unhealthy health events without a node name routed to alerting instead of quarantine",
+		},
+		[]string{"agent", "check_name"},
+	)
+	WarningEvents = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_quarantine_warning_events_total",
+			Help: "Total number of non-fatal health events recorded as warnings without quarantining the node",
+		},
+		[]string{"node", "check_name"},
+	)
+
 	// Taint and Cordon Metrics
 	TaintsApplied = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -180,6 +216,21 @@ var (
 			Help: "Utilization of the fault quarantine breaker.",
 		},
 	)
+
+	// Quarantine Budget Metrics
+	QuarantinesDeferredByBudget = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_quarantine_quarantines_deferred_by_budget_total",
+			Help: "Total number of node quarantines deferred because the quarantine budget was exhausted.",
+		},
+		[]string{"node"},
+	)
+	QuarantineBudgetExhausted = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "fault_quarantine_budget_exhausted",
+			Help: "Whether the quarantine budget is exhausted (1) or has room for new quarantines (0).",
+		},
+	)
 	FaultQuarantineGetTotalNodesDuration = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "fault_quarantine_get_total_nodes_duration_seconds",
@@ -0,0 +1,306 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/eventwatcher"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/metrics"
+)
+
+// deferredQuarantineRetryInterval is how often deferred quarantines are retried when no
+// quarantined node has been released in the meantime.
+const deferredQuarantineRetryInterval = 30 * time.Second
+
+// quarantineBudgetLimit returns the maximum number of nodes that may be quarantined at once
+// for a cluster of totalNodes, or 0 when no budget is configured. When both a count and a
+// percentage are set, the stricter of the two applies.
+func (r *Reconciler) quarantineBudgetLimit(totalNodes int) int {
+	budget := r.config.TomlConfig.QuarantineBudget
+	limit := budget.MaxNodes
+
+	if budget.MaxPercentage > 0 {
+		byPercentage := int(math.Ceil(float64(totalNodes) * float64(budget.MaxPercentage) / 100))
+		if limit <= 0 || byPercentage < limit {
+			limit = byPercentage
+		}
+	}
+
+	return limit
+}
+
+// quarantineBudgetExhausted reports whether quarantining the event's node would exceed the
+// configured quarantine budget. Force-quarantine overrides bypass the budget, as they do the
+// circuit breaker. The caller records the deferral on the node so that it can be retried once
+// the budget has room again.
+func (r *Reconciler) quarantineBudgetExhausted(ctx context.Context, event *model.HealthEventWithStatus) bool {
+	budget := r.config.TomlConfig.QuarantineBudget
+	if budget.MaxNodes <= 0 && budget.MaxPercentage <= 0 {
+		return false
+	}
+
+	if r.isForceQuarantine(event.HealthEvent) {
+		return false
+	}
+
+	totalNodes, quarantinedNodes, err := r.k8sClient.NodeInformer.GetNodeCounts()
+	if err != nil {
+		// Fail open: an unavailable node count must not block quarantines of faulty nodes.
+		slog.WarnContext(ctx, "Failed to get node counts for quarantine budget; not enforcing budget", "error", err)
+
+		return false
+	}
+
+	limit := r.quarantineBudgetLimit(totalNodes)
+	exhausted := len(quarantinedNodes) >= limit && !quarantinedNodes[event.HealthEvent.NodeName]
+
+	if !exhausted {
+		metrics.QuarantineBudgetExhausted.Set(0)
+
+		return false
+	}
+
+	metrics.QuarantineBudgetExhausted.Set(1)
+	metrics.QuarantinesDeferredByBudget.WithLabelValues(event.HealthEvent.NodeName).Inc()
+
+	slog.WarnContext(ctx, "Quarantine budget exhausted, deferring quarantine of node",
+		"node", event.HealthEvent.NodeName,
+		"checkName", event.HealthEvent.CheckName,
+		"quarantinedNodes", len(quarantinedNodes),
+		"totalNodes", totalNodes,
+		"budget", limit)
+
+	return true
+}
+
+// quarantineBudgetEnabled reports whether a quarantine budget is configured.
+func (r *Reconciler) quarantineBudgetEnabled() bool {
+	budget := r.config.TomlConfig.QuarantineBudget
+
+	return budget.MaxNodes > 0 || budget.MaxPercentage > 0
+}
+
+// deferredHealthEvent returns the health event recorded in the node's deferred quarantine
+// annotation, or nil when the node has no deferred quarantine.
+func deferredHealthEvent(node *v1.Node) (*protos.HealthEvent, error) {
+	return annotatedHealthEvent(node, common.QuarantineDeferredHealthEventAnnotationKey)
+}
+
+// annotatedHealthEvent returns the health event recorded in the given node annotation, or nil
+// when the annotation is not set.
+func annotatedHealthEvent(node *v1.Node, annotationKey string) (*protos.HealthEvent, error) {
+	value := node.Annotations[annotationKey]
+	if value == "" {
+		return nil, nil
+	}
+
+	event := &protos.HealthEvent{}
+	if err := json.Unmarshal([]byte(value), event); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s annotation for node %s: %w", annotationKey, node.Name, err)
+	}
+
+	return event, nil
+}
+
+// deferQuarantine records the event on its node so that the quarantine is retried once the
+// budget has room. A later deferred event for the same node replaces the earlier one.
+func (r *Reconciler) deferQuarantine(ctx context.Context, event *model.HealthEventWithStatus) {
+	nodeName := event.HealthEvent.NodeName
+
+	eventJSON, err := json.Marshal(event.HealthEvent)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to marshal deferred health event", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("defer_quarantine_error").Inc()
+
+		return
+	}
+
+	err = r.k8sClient.UpdateNode(ctx, nodeName, func(node *v1.Node) error {
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+
+		node.Annotations[common.QuarantineDeferredHealthEventAnnotationKey] = string(eventJSON)
+
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to record deferred quarantine on node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("defer_quarantine_error").Inc()
+	}
+}
+
+// clearDeferredQuarantine removes the node's deferred quarantine once the node has been
+// quarantined, or once the deferred check has recovered when recoveredCheck is set.
+func (r *Reconciler) clearDeferredQuarantine(ctx context.Context, nodeName, recoveredCheck string) {
+	node, err := r.k8sClient.NodeInformer.GetNode(nodeName)
+	if err != nil || node.Annotations[common.QuarantineDeferredHealthEventAnnotationKey] == "" {
+		return
+	}
+
+	err = r.k8sClient.UpdateNode(ctx, nodeName, func(node *v1.Node) error {
+		if recoveredCheck != "" {
+			deferred, err := deferredHealthEvent(node)
+			if err == nil && deferred != nil && deferred.CheckName != recoveredCheck {
+				return nil
+			}
+		}
+
+		delete(node.Annotations, common.QuarantineDeferredHealthEventAnnotationKey)
+
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to clear deferred quarantine on node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("defer_quarantine_error").Inc()
+	}
+}
+
+// signalBudgetReleased wakes the deferred quarantine retry loop after a quarantined node has
+// been released.
+func (r *Reconciler) signalBudgetReleased() {
+	select {
+	case r.budgetReleased <- struct{}{}:
+	default:
+	}
+}
+
+// runDeferredQuarantineRetries retries deferred quarantines whenever a quarantined node is
+// released, and periodically to catch releases made while fault-quarantine was down.
+func (r *Reconciler) runDeferredQuarantineRetries(
+	ctx context.Context,
+	process func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status,
+) {
+	ticker := time.NewTicker(deferredQuarantineRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.budgetReleased:
+		}
+
+		r.retryDeferredQuarantines(ctx, process)
+	}
+}
+
+// retryDeferredQuarantines reprocesses deferred health events, oldest first, for as many nodes
+// as the quarantine budget has room for. Each event goes through the normal processing path,
+// so it is deferred again if the budget filled up in the meantime, and its resulting status is
+// recorded on the health event like any other.
+func (r *Reconciler) retryDeferredQuarantines(
+	ctx context.Context,
+	process func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status,
+) {
+	totalNodes, quarantinedNodes, err := r.k8sClient.NodeInformer.GetNodeCounts()
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to get node counts, not retrying deferred quarantines", "error", err)
+
+		return
+	}
+
+	room := r.quarantineBudgetLimit(totalNodes) - len(quarantinedNodes)
+	if r.quarantineBudgetEnabled() && room <= 0 {
+		return
+	}
+
+	metrics.QuarantineBudgetExhausted.Set(0)
+
+	deferred := r.listDeferredHealthEvents(ctx)
+
+	if r.quarantineBudgetEnabled() && len(deferred) > room {
+		deferred = deferred[:room]
+	}
+
+	for _, event := range deferred {
+		slog.InfoContext(ctx, "Retrying deferred quarantine", "node", event.NodeName, "checkName", event.CheckName)
+
+		// Clear the deferral before reprocessing: the event records it again if the budget is
+		// still exhausted.
+		r.clearDeferredQuarantine(ctx, event.NodeName, "")
+
+		r.reprocessHealthEvent(ctx, process, event)
+	}
+}
+
+// reprocessHealthEvent sends a health event recorded on a node through the normal processing
+// path again and records the resulting status on the health event like any other.
+func (r *Reconciler) reprocessHealthEvent(
+	ctx context.Context,
+	process func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status,
+	event *protos.HealthEvent,
+) {
+	healthEventWithStatus := &model.HealthEventWithStatus{HealthEvent: event}
+
+	status := process(ctx, healthEventWithStatus)
+	if status == nil || r.eventWatcher == nil {
+		return
+	}
+
+	if err := r.eventWatcher.UpdateNodeQuarantineStatus(ctx, event.Id, status); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("update_quarantine_status_error").Inc()
+		slog.ErrorContext(ctx, "Failed to update node quarantine status for reprocessed event",
+			"node", event.NodeName, "error", err)
+
+		return
+	}
+
+	eventwatcher.EmitNodeQuarantineDuration(status, healthEventWithStatus)
+}
+
+// listDeferredHealthEvents returns the deferred health events of all nodes, oldest first.
+func (r *Reconciler) listDeferredHealthEvents(ctx context.Context) []*protos.HealthEvent {
+	nodes, err := r.k8sClient.NodeInformer.ListNodes()
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list nodes for deferred quarantines", "error", err)
+
+		return nil
+	}
+
+	var events []*protos.HealthEvent
+
+	for _, node := range nodes {
+		event, err := deferredHealthEvent(node)
+		if err != nil {
+			slog.WarnContext(ctx, "Ignoring invalid deferred quarantine", "node", node.Name, "error", err)
+
+			continue
+		}
+
+		if event != nil {
+			events = append(events, event)
+		}
+	}
+
+	slices.SortFunc(events, func(a, b *protos.HealthEvent) int {
+		return a.GetGeneratedTimestamp().AsTime().Compare(b.GetGeneratedTimestamp().AsTime())
+	})
+
+	return events
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/metrics"
+)
+
+// isClusterScopedEvent reports whether the event names no node, e.g. a cluster-wide fault.
+func isClusterScopedEvent(event *model.HealthEventWithStatus) bool {
+	return event.HealthEvent.GetNodeName() == ""
+}
+
+// handleClusterScopedEvent processes an event that names no node. No node is looked up, cordoned
+// or tainted. If cluster-scoped handling is enabled, an unhealthy event is raised as an alert and
+// the event is recorded with the ClusterScoped status, which node-drainer and fault-remediation
+// do not act on. Otherwise the event is skipped.
+func (r *Reconciler) handleClusterScopedEvent(ctx context.Context, event *model.HealthEventWithStatus) *model.Status {
+	span := tracing.SpanFromContext(ctx)
+	healthEvent := event.HealthEvent
+
+	if !r.config.TomlConfig.ClusterScopedEvents.Enabled {
+		slog.WarnContext(ctx, "Skipping health event without a node name; cluster-scoped event handling is disabled",
+			"agent", healthEvent.GetAgent(),
+			"checkName", healthEvent.GetCheckName())
+		span.SetAttributes(
+			attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusSkipped),
+			attribute.String("fault_quarantine.skip.reason", "Event has no node name"),
+		)
+
+		return nil
+	}
+
+	span.SetAttributes(
+		attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusClusterScoped),
+	)
+
+	if healthEvent.GetIsHealthy() {
+		slog.InfoContext(ctx, "Cluster-scoped health check recovered",
+			"agent", healthEvent.GetAgent(),
+			"checkName", healthEvent.GetCheckName())
+	} else {
+		metrics.ClusterScopedEvents.WithLabelValues(healthEvent.GetAgent(), healthEvent.GetCheckName()).Inc()
+
+		slog.WarnContext(ctx, "ALERT: Cluster-scoped health event reported; no node will be quarantined",
+			"agent", healthEvent.GetAgent(),
+			"checkName", healthEvent.GetCheckName(),
+			"isFatal", healthEvent.GetIsFatal(),
+			"recommendedAction", healthEvent.GetRecommendedAction().String(),
+			"message", healthEvent.GetMessage())
+	}
+
+	status := model.ClusterScoped
+
+	return &status
+}
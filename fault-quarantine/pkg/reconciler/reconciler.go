@@ -28,6 +28,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
 	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
@@ -50,8 +51,15 @@ const (
 	EventProcessingStatusSkipped         = "skipped"
 	EventProcessingStatusHalted          = "halted"
 	EventProcessingStatusPartialRecovery = "partial_recovery"
+	EventProcessingStatusDeferred        = "deferred"
+	EventProcessingStatusWarning         = "warning"
+	EventProcessingStatusClusterScoped   = "cluster_scoped"
 )
 
+// labelTimestampLayout formats the cordon and uncordon timestamp labels. Label values may not
+// contain colons.
+const labelTimestampLayout = "2006-01-02T15-04-05Z"
+
 type ReconcilerConfig struct {
 	TomlConfig            config.TomlConfig
 	DryRun                bool
@@ -73,6 +81,12 @@ type keyValTaint struct {
 	Value string
 }
 
+// PreflightRunner runs node-level preflight GPU health checks on a remediated node
+// before it is returned to service. It reports whether the node passed.
+type PreflightRunner interface {
+	RunPreflight(ctx context.Context, nodeName string) (bool, error)
+}
+
 type Reconciler struct {
 	config                ReconcilerConfig
 	k8sClient             *informer.FaultQuarantineClient
@@ -81,11 +95,15 @@ type Reconciler struct {
 	eventWatcher          eventwatcher.EventWatcherInterface
 	taintInitKeys         []keyValTaint // Pre-computed taint keys for map initialization
 	taintUpdateMu         sync.Mutex    // Protects taint priority updates
+	processMu             sync.Mutex    // Serializes event processing with deferred quarantine retries
+	budgetReleased        chan struct{} // Signals that a quarantined node was released
+	preflightRunner       PreflightRunner
 
 	// Label keys
 	cordonedByLabelKey        string
 	cordonedReasonLabelKey    string
 	cordonedTimestampLabelKey string
+	cordonLabelKey            string
 
 	uncordonedByLabelKey        string
 	uncordonedReasonLabelKey    string
@@ -106,9 +124,10 @@ func NewReconciler(
 	circuitBreaker breaker.CircuitBreaker,
 ) *Reconciler {
 	r := &Reconciler{
-		config:    cfg,
-		k8sClient: k8sClient,
-		cb:        circuitBreaker,
+		config:         cfg,
+		k8sClient:      k8sClient,
+		cb:             circuitBreaker,
+		budgetReleased: make(chan struct{}, 1),
 	}
 
 	return r
@@ -119,11 +138,20 @@ func (r *Reconciler) SetLabelKeys(labelKeyPrefix string) {
 	r.cordonedReasonLabelKey = labelKeyPrefix + "cordon-reason"
 	r.cordonedTimestampLabelKey = labelKeyPrefix + "cordon-timestamp"
 
+	// The cordon label gate is opt-in: nodes quarantined before it was configured do not carry
+	// the label and would otherwise never be uncordoned.
+	r.cordonLabelKey = r.config.TomlConfig.CordonLabelKey
+
 	r.uncordonedByLabelKey = labelKeyPrefix + "uncordon-by"
 	r.uncordonedReasonLabelKey = labelKeyPrefix + "uncordon-reason"
 	r.uncordonedTimestampLabelKey = labelKeyPrefix + "uncordon-timestamp"
 }
 
+// SetPreflightRunner sets the runner consulted during post-remediation verification.
+func (r *Reconciler) SetPreflightRunner(runner PreflightRunner) {
+	r.preflightRunner = runner
+}
+
 func (r *Reconciler) StoreLastProcessedObjectID(objID string) {
 	r.lastProcessedObjectID.Store(objID)
 }
@@ -218,11 +246,14 @@ func (r *Reconciler) Start(ctx context.Context) error {
 
 	r.initializeQuarantineMetrics(ctx)
 
-	r.eventWatcher.SetProcessEventCallback(
-		func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status {
-			return r.ProcessEvent(ctx, event, ruleSetEvals, rulesetsConfig)
-		},
-	)
+	processEvent := func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status {
+		return r.ProcessEvent(ctx, event, ruleSetEvals, rulesetsConfig)
+	}
+
+	r.eventWatcher.SetProcessEventCallback(processEvent)
+
+	go r.runDeferredQuarantineRetries(ctx, processEvent)
+	go r.runPendingVerificationRetries(ctx, processEvent)
 
 	r.eventWatcher.SetFetchDocIDsFn(r.sourceDocIDsFromAnnotation)
 
@@ -267,10 +298,12 @@ func (r *Reconciler) SetupNodeInformerCallbacks() {
 	r.k8sClient.NodeInformer.SetOnQuarantinedNodeDeletedCallback(func(nodeName string) {
 		metrics.CurrentQuarantinedNodes.WithLabelValues(nodeName).Set(0)
 		slog.Info("Set currentQuarantinedNodes to 0 for deleted quarantined node", "node", nodeName)
+		r.signalBudgetReleased()
 	})
 
 	r.k8sClient.NodeInformer.SetOnManualUncordonCallback(r.handleManualUncordon)
 	r.k8sClient.NodeInformer.SetOnManualUntaintCallback(r.handleManualUntaint)
+	r.k8sClient.NodeInformer.SetOnUncordonApprovedCallback(r.handleUncordonApproval)
 }
 
 // initializeRuleSetEvaluators initializes all rule set evaluators from config
@@ -287,6 +320,7 @@ func (r *Reconciler) initializeRuleSetEvaluators() ([]evaluator.RuleSetEvaluator
 func (r *Reconciler) setupLabelKeys() {
 	r.SetLabelKeys(r.config.TomlConfig.LabelPrefix)
 	r.k8sClient.SetLabelKeys(r.cordonedReasonLabelKey, r.uncordonedReasonLabelKey)
+	r.k8sClient.SetCordonLabelKey(r.cordonLabelKey)
 }
 
 // buildRulesetsConfig builds the rulesets configuration maps from TOML config
@@ -446,6 +480,9 @@ func (r *Reconciler) ProcessEvent(
 	ruleSetEvals []evaluator.RuleSetEvaluatorIface,
 	rulesetsConfig rulesetsConfig,
 ) *model.Status {
+	r.processMu.Lock()
+	defer r.processMu.Unlock()
+
 	span := tracing.SpanFromContext(ctx)
 
 	if shouldHalt := r.checkCircuitBreakerAndHalt(ctx); shouldHalt {
@@ -456,6 +493,15 @@ func (r *Reconciler) ProcessEvent(
 
 	slog.DebugContext(ctx, "Processing event", "checkName", event.HealthEvent.CheckName)
 
+	if isClusterScopedEvent(event) {
+		status := r.handleClusterScopedEvent(ctx, event)
+		if status != nil {
+			metrics.TotalEventsSuccessfullyProcessed.Inc()
+		}
+
+		return status
+	}
+
 	isNodeQuarantined := r.handleEvent(ctx, event, ruleSetEvals, rulesetsConfig)
 
 	if isNodeQuarantined == nil {
@@ -532,6 +578,9 @@ func (r *Reconciler) handleEvent(
 	// For healthy events, if there's no existing quarantine annotation,
 	// skip processing as there's no transition from unhealthy to healthy
 	if event.HealthEvent.IsHealthy {
+		r.clearWarningPhase(ctx, event)
+		r.clearDeferredQuarantine(ctx, event.HealthEvent.NodeName, event.HealthEvent.CheckName)
+
 		slog.InfoContext(ctx, "Skipping healthy event for node as there's no existing quarantine annotation",
 			"node", event.HealthEvent.NodeName, "event", event.HealthEvent)
 		span.SetAttributes(
@@ -561,12 +610,21 @@ func (r *Reconciler) handleEvent(
 
 	taintsToBeApplied := r.collectTaintsToApply(taintAppliedMap)
 
-	annotationsMap := r.prepareAnnotations(ctx, taintsToBeApplied, &labelsMap, &isCordoned)
+	annotationsMap := r.prepareAnnotations(ctx, event, taintsToBeApplied, &labelsMap, &isCordoned)
 
 	isNodeQuarantined := len(taintsToBeApplied) > 0 || isCordoned.Load()
 
 	// In dry-run mode, always apply annotations for observability even if no actions would be taken
 	if !isNodeQuarantined && !r.config.DryRun {
+		if r.isWarningEvent(event) {
+			r.enterWarningPhase(ctx, event)
+			span.SetAttributes(
+				attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusWarning),
+			)
+
+			return nil
+		}
+
 		span.SetAttributes(
 			attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusSkipped),
 			attribute.String("fault_quarantine.skip.reason", "No quarantine actions required"),
@@ -575,11 +633,25 @@ func (r *Reconciler) handleEvent(
 		return nil
 	}
 
+	if isNodeQuarantined && r.quarantineBudgetExhausted(ctx, event) {
+		r.deferQuarantine(ctx, event)
+		span.SetAttributes(
+			attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusDeferred),
+			attribute.String("fault_quarantine.skip.reason", "Quarantine budget exhausted"),
+		)
+
+		return nil
+	}
+
 	status := r.applyQuarantine(
 		ctx, event, annotations, taintsToBeApplied,
 		annotationsMap, &labelsMap, &isCordoned,
 	)
 
+	if status != nil {
+		r.clearDeferredQuarantine(ctx, event.HealthEvent.NodeName, "")
+	}
+
 	return status
 }
 
@@ -851,6 +923,7 @@ func (r *Reconciler) collectTaintsToApply(taintAppliedMap map[keyValTaint]string
 // prepareAnnotations prepares annotations and labels to be applied if any
 func (r *Reconciler) prepareAnnotations(
 	ctx context.Context,
+	event *model.HealthEventWithStatus,
 	taintsToBeApplied []config.Taint,
 	labelsMap *sync.Map,
 	isCordoned *atomic.Bool,
@@ -871,7 +944,12 @@ func (r *Reconciler) prepareAnnotations(
 			common.QuarantineHealthEventIsCordonedAnnotationValueTrue
 
 		labelsMap.LoadOrStore(r.cordonedByLabelKey, common.ServiceName)
-		labelsMap.Store(r.cordonedTimestampLabelKey, time.Now().UTC().Format("2006-01-02T15-04-05Z"))
+		labelsMap.Store(r.cordonedTimestampLabelKey, time.Now().UTC().Format(labelTimestampLayout))
+		// The cordon label references the event that caused the cordon and is what uncordon checks
+		// to tell NVSentinel cordons apart from manual ones.
+		if r.cordonLabelKey != "" {
+			labelsMap.LoadOrStore(r.cordonLabelKey, cordonEventReference(event))
+		}
 	}
 
 	if len(taintsToBeApplied) > 0 || isCordoned.Load() {
@@ -1019,6 +1097,7 @@ func (r *Reconciler) updateQuarantineMetrics(
 ) {
 	metrics.TotalNodesQuarantined.WithLabelValues(nodeName).Inc()
 	metrics.CurrentQuarantinedNodes.WithLabelValues(nodeName).Set(1)
+	metrics.CurrentWarningNodes.WithLabelValues(nodeName).Set(0)
 
 	for _, taint := range taintsToBeApplied {
 		metrics.TaintsApplied.WithLabelValues(taint.Key, taint.Effect).Inc()
@@ -1067,6 +1146,8 @@ func (r *Reconciler) handleUnhealthyEventOnQuarantinedNode(
 		return true
 	}
 
+	r.clearPendingVerification(ctx, event.NodeName, event.CheckName)
+
 	added := healthEventsAnnotationMap.AddOrUpdateEvent(event)
 
 	if added {
@@ -1085,6 +1166,88 @@ func (r *Reconciler) handleUnhealthyEventOnQuarantinedNode(
 	return true
 }
 
+// verifyPostRemediation reports whether a node may be uncordoned after all of its tracked
+// checks recovered. Only nodes that went through remediation are verified: each required
+// condition must be present, healthy, and have a heartbeat newer than the cordon timestamp,
+// and the preflight runner, if set, must pass.
+func (r *Reconciler) verifyPostRemediation(ctx context.Context, nodeName string) bool {
+	verification := r.config.TomlConfig.PostRemediationVerification
+	if !verification.Enabled || (len(verification.RequiredHealthyConditions) == 0 && r.preflightRunner == nil) {
+		return true
+	}
+
+	node, err := r.k8sClient.NodeInformer.GetNode(nodeName)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to get node for post-remediation verification", "node", nodeName, "error", err)
+		metrics.PostRemediationVerifications.WithLabelValues(metrics.StatusFailed).Inc()
+
+		return false
+	}
+
+	if node.Labels[statemanager.NVSentinelStateLabelKey] != string(statemanager.RemediationSucceededLabelValue) {
+		return true
+	}
+
+	var quarantinedAt time.Time
+
+	if ts, ok := node.Labels[r.cordonedTimestampLabelKey]; ok {
+		if parsed, err := time.Parse(labelTimestampLayout, ts); err == nil {
+			quarantinedAt = parsed
+		}
+	}
+
+	for _, conditionType := range verification.RequiredHealthyConditions {
+		if reason := unverifiedConditionReason(node, conditionType, quarantinedAt); reason != "" {
+			slog.InfoContext(ctx, "Post-remediation verification failed, node remains quarantined",
+				"node", nodeName,
+				"condition", conditionType,
+				"reason", reason)
+			metrics.PostRemediationVerifications.WithLabelValues(metrics.StatusFailed).Inc()
+
+			return false
+		}
+	}
+
+	if r.preflightRunner != nil {
+		passed, err := r.preflightRunner.RunPreflight(ctx, nodeName)
+		if err != nil || !passed {
+			slog.InfoContext(ctx, "Post-remediation preflight check did not pass, node remains quarantined",
+				"node", nodeName,
+				"error", err)
+			metrics.PostRemediationVerifications.WithLabelValues(metrics.StatusFailed).Inc()
+
+			return false
+		}
+	}
+
+	slog.InfoContext(ctx, "Post-remediation verification passed", "node", nodeName)
+	metrics.PostRemediationVerifications.WithLabelValues(metrics.StatusPassed).Inc()
+
+	return true
+}
+
+// unverifiedConditionReason returns why the given condition does not prove the node healthy,
+// or an empty string if it does.
+func unverifiedConditionReason(node *corev1.Node, conditionType string, quarantinedAt time.Time) string {
+	for _, condition := range node.Status.Conditions {
+		if string(condition.Type) != conditionType {
+			continue
+		}
+
+		if condition.Status != corev1.ConditionFalse {
+			return "condition is not healthy"
+		}
+
+		if condition.LastHeartbeatTime.Time.Before(quarantinedAt) {
+			return "condition has not been reported since quarantine"
+		}
+
+		return ""
+	}
+
+	return "condition not found"
+}
+
 func (r *Reconciler) handleQuarantinedNode(
 	ctx context.Context,
 	event *protos.HealthEvent,
@@ -1140,6 +1303,18 @@ func (r *Reconciler) handleQuarantinedNode(
 			"node", event.NodeName)
 	}
 
+	// Keep the last tracked entities on a remediated node until its health is verified,
+	// so that a later healthy event retries the verification instead of being ignored.
+	if healthEventsAnnotationMap.IsEmpty() && !r.verifyPostRemediation(ctx, event.NodeName) {
+		r.recordPendingVerification(ctx, event)
+		span.SetAttributes(
+			attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusSkipped),
+			attribute.String("fault_quarantine.skip.reason", "Post-remediation verification failed"),
+		)
+
+		return true
+	}
+
 	updatedHealthEventsMap, err := r.removeEventFromAnnotation(ctx, event)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to update health events annotation after recovery", "error", err)
@@ -1153,6 +1328,23 @@ func (r *Reconciler) handleQuarantinedNode(
 	}
 
 	if updatedHealthEventsMap.IsEmpty() {
+		if r.requiresUncordonApproval(annotations) {
+			if err := r.awaitUncordonApproval(ctx, event.NodeName); err != nil {
+				tracing.RecordError(span, err)
+				span.SetAttributes(
+					attribute.String("fault_quarantine.error.type", "set_awaiting_uncordon_condition_error"),
+					attribute.String("fault_quarantine.error.message", err.Error()),
+				)
+			}
+
+			span.SetAttributes(
+				attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusSkipped),
+				attribute.String("fault_quarantine.skip.reason", "Uncordon awaits operator approval"),
+			)
+
+			return true
+		}
+
 		slog.InfoContext(ctx, "All health checks recovered for node, proceeding with uncordon",
 			"node", event.NodeName)
 
@@ -1399,7 +1591,12 @@ func (r *Reconciler) performUncordon(
 			"node", event.NodeName)
 	}
 
-	annotationsToBeRemoved = append(annotationsToBeRemoved, common.QuarantineHealthEventAnnotationKey)
+	annotationsToBeRemoved = append(annotationsToBeRemoved, common.QuarantineHealthEventAnnotationKey,
+		common.WarningHealthEventsAnnotationKey)
+
+	if _, approved := annotations[common.QuarantinedNodeUncordonApprovedAnnotationKey]; approved {
+		annotationsToBeRemoved = append(annotationsToBeRemoved, common.QuarantinedNodeUncordonApprovedAnnotationKey)
+	}
 
 	if !r.config.CircuitBreakerEnabled {
 		slog.InfoContext(ctx, "Circuit breaker is disabled, proceeding with unquarantine action for node",
@@ -1410,6 +1607,7 @@ func (r *Reconciler) performUncordon(
 		r.cordonedByLabelKey,
 		r.cordonedReasonLabelKey,
 		r.cordonedTimestampLabelKey,
+		r.cordonLabelKey,
 		statemanager.NVSentinelStateLabelKey,
 	}
 
@@ -1433,6 +1631,7 @@ func (r *Reconciler) performUncordon(
 	}
 
 	r.updateUncordonMetrics(ctx, event.NodeName, taintsToBeRemoved, isUnCordon)
+	r.signalBudgetReleased()
 
 	span.SetAttributes(
 		attribute.Bool("fault_quarantine.action.uncordon", isUnCordon),
@@ -1474,7 +1673,12 @@ func (r *Reconciler) prepareUncordonParams(
 		annotationsToBeRemoved = append(annotationsToBeRemoved,
 			common.QuarantineHealthEventIsCordonedAnnotationKey)
 		labelsMap[r.uncordonedByLabelKey] = common.ServiceName
-		labelsMap[r.uncordonedTimestampLabelKey] = time.Now().UTC().Format("2006-01-02T15-04-05Z")
+		labelsMap[r.uncordonedTimestampLabelKey] = time.Now().UTC().Format(labelTimestampLayout)
+	}
+
+	if annotations[common.PostRemediationVerificationPendingAnnotationKey] != "" {
+		annotationsToBeRemoved = append(annotationsToBeRemoved,
+			common.PostRemediationVerificationPendingAnnotationKey)
 	}
 
 	return taintsToBeRemoved, annotationsToBeRemoved, isUnCordon, labelsMap, nil
@@ -1499,6 +1703,16 @@ func (r *Reconciler) updateUncordonMetrics(
 	}
 }
 
+// cordonEventReference returns a label-safe reference to the event that caused the cordon.
+func cordonEventReference(event *model.HealthEventWithStatus) string {
+	ref := formatCordonOrUncordonReasonValue(event.HealthEvent.Agent+"-"+event.HealthEvent.CheckName, 63)
+	if ref == "" {
+		return common.ServiceName
+	}
+
+	return ref
+}
+
 func formatCordonOrUncordonReasonValue(input string, length int) string {
 	formatted := labelValueRegex.ReplaceAllString(input, "-")
 
@@ -1526,6 +1740,8 @@ func (r *Reconciler) getNodeQuarantineAnnotations(ctx context.Context, nodeName
 		common.QuarantineHealthEventAppliedTaintsAnnotationKey,
 		common.QuarantineHealthEventIsCordonedAnnotationKey,
 		common.QuarantinedNodeUncordonedManuallyAnnotationKey,
+		common.QuarantinedNodeUncordonApprovedAnnotationKey,
+		common.PostRemediationVerificationPendingAnnotationKey,
 	}
 
 	if node.Annotations != nil {
@@ -1572,6 +1788,134 @@ func (r *Reconciler) cleanupManualUncordonAnnotation(ctx context.Context, nodeNa
 	return nil
 }
 
+// requiresUncordonApproval reports whether a fully recovered node must wait for operator approval
+// before being uncordoned. An approval given ahead of the recovery is honoured immediately.
+func (r *Reconciler) requiresUncordonApproval(annotations map[string]string) bool {
+	if r.config.TomlConfig.UncordonPolicy != config.UncordonPolicyManual {
+		return false
+	}
+
+	return annotations[common.QuarantinedNodeUncordonApprovedAnnotationKey] !=
+		common.QuarantinedNodeUncordonApprovedAnnotationValue
+}
+
+// awaitUncordonApproval keeps a recovered node cordoned and flags it with the AwaitingUncordon condition
+func (r *Reconciler) awaitUncordonApproval(ctx context.Context, nodeName string) error {
+	slog.InfoContext(ctx, "All health checks recovered for node, keeping it cordoned until uncordon is approved",
+		"node", nodeName,
+		"approvalAnnotation", common.QuarantinedNodeUncordonApprovedAnnotationKey)
+
+	if err := r.setAwaitingUncordonCondition(ctx, nodeName, true); err != nil {
+		slog.ErrorContext(ctx, "Failed to set awaiting uncordon condition", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("set_awaiting_uncordon_condition_error").Inc()
+
+		return err
+	}
+
+	metrics.NodesAwaitingUncordon.WithLabelValues(nodeName).Set(1)
+
+	return nil
+}
+
+// setAwaitingUncordonCondition adds or removes the AwaitingUncordon condition on the node
+func (r *Reconciler) setAwaitingUncordonCondition(ctx context.Context, nodeName string, awaiting bool) error {
+	updateFn := func(node *corev1.Node) error {
+		conditions := make([]corev1.NodeCondition, 0, len(node.Status.Conditions)+1)
+
+		for _, condition := range node.Status.Conditions {
+			if condition.Type != common.AwaitingUncordonConditionType {
+				conditions = append(conditions, condition)
+			}
+		}
+
+		if awaiting {
+			now := metav1.Now()
+			conditions = append(conditions, corev1.NodeCondition{
+				Type:               common.AwaitingUncordonConditionType,
+				Status:             corev1.ConditionTrue,
+				LastHeartbeatTime:  now,
+				LastTransitionTime: now,
+				Reason:             "HealthChecksRecovered",
+				Message: fmt.Sprintf("All health checks recovered; annotate the node with %s=%s to uncordon it",
+					common.QuarantinedNodeUncordonApprovedAnnotationKey,
+					common.QuarantinedNodeUncordonApprovedAnnotationValue),
+			})
+		}
+
+		node.Status.Conditions = conditions
+
+		return nil
+	}
+
+	if err := r.k8sClient.UpdateNodeStatus(ctx, nodeName, updateFn); err != nil {
+		return fmt.Errorf("failed to update awaiting uncordon condition on node %s: %w", nodeName, err)
+	}
+
+	return nil
+}
+
+// handleUncordonApproval uncordons a recovered node once an operator approves it. If the node has
+// started failing again in the meantime, the approval is discarded and the node stays quarantined.
+func (r *Reconciler) handleUncordonApproval(nodeName string) error {
+	ctx, span := tracing.StartSpan(context.Background(), "fault_quarantine.uncordon_approval")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("fault_quarantine.node_name", nodeName))
+
+	event := &protos.HealthEvent{NodeName: nodeName}
+
+	healthEventsAnnotationMap, annotations, err := r.getHealthEventsFromAnnotation(ctx, event)
+	if err != nil {
+		if errors.Is(err, errNoQuarantineAnnotation) {
+			slog.InfoContext(ctx, "Uncordon approved for node that is not quarantined, ignoring", "node", nodeName)
+			return nil
+		}
+
+		tracing.RecordError(span, err)
+
+		return fmt.Errorf("failed to get health events for approved node %s: %w", nodeName, err)
+	}
+
+	if !healthEventsAnnotationMap.IsEmpty() {
+		slog.WarnContext(ctx, "Uncordon approved for node that still has failing checks, keeping it quarantined",
+			"node", nodeName,
+			"checks", healthEventsAnnotationMap.GetAllCheckNames())
+		span.SetAttributes(
+			attribute.String("fault_quarantine.event.processing_status", EventProcessingStatusSkipped),
+			attribute.String("fault_quarantine.skip.reason", "Node still has failing checks"),
+		)
+
+		return r.k8sClient.UpdateNode(ctx, nodeName, func(node *corev1.Node) error {
+			delete(node.Annotations, common.QuarantinedNodeUncordonApprovedAnnotationKey)
+			return nil
+		})
+	}
+
+	if _, err := r.performUncordon(ctx, event, annotations); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("uncordon_error").Inc()
+		tracing.RecordError(span, err)
+		span.SetAttributes(
+			attribute.String("fault_quarantine.error.type", "uncordon_error"),
+			attribute.String("fault_quarantine.error.message", err.Error()),
+		)
+
+		return fmt.Errorf("failed to uncordon approved node %s: %w", nodeName, err)
+	}
+
+	metrics.NodesAwaitingUncordon.WithLabelValues(nodeName).Set(0)
+
+	if err := r.setAwaitingUncordonCondition(ctx, nodeName, false); err != nil {
+		metrics.ProcessingErrors.WithLabelValues("set_awaiting_uncordon_condition_error").Inc()
+		tracing.RecordError(span, err)
+
+		return err
+	}
+
+	slog.InfoContext(ctx, "Uncordoned node after operator approval", "node", nodeName)
+
+	return nil
+}
+
 // handleManualUncordon handles the case when a node is manually uncordoned while having FQ annotations
 func (r *Reconciler) handleManualUncordon(nodeName string) error {
 	ctx, span := tracing.StartSpan(context.Background(), "fault_quarantine.manual_uncordon")
@@ -1620,7 +1964,7 @@ func (r *Reconciler) handleManualUncordon(nodeName string) error {
 		nodeName,
 		annotationsToRemove,
 		newAnnotations,
-		[]string{statemanager.NVSentinelStateLabelKey},
+		[]string{statemanager.NVSentinelStateLabelKey, r.cordonLabelKey},
 	); err != nil {
 		slog.ErrorContext(ctx, "Failed to clean up manually uncordoned node", "node", nodeName, "error", err)
 		metrics.ProcessingErrors.WithLabelValues("manual_uncordon_cleanup_error").Inc()
@@ -1639,6 +1983,17 @@ func (r *Reconciler) handleManualUncordon(nodeName string) error {
 	slog.InfoContext(ctx, "Set currentQuarantinedNodes to 0 for manually uncordoned node", "node", nodeName)
 	metrics.CurrentQuarantinedNodes.WithLabelValues(nodeName).Set(0)
 	metrics.TotalNodesManuallyUncordoned.WithLabelValues(nodeName).Inc()
+	r.signalBudgetReleased()
+
+	if r.config.TomlConfig.UncordonPolicy == config.UncordonPolicyManual {
+		metrics.NodesAwaitingUncordon.WithLabelValues(nodeName).Set(0)
+
+		if err := r.setAwaitingUncordonCondition(ctx, nodeName, false); err != nil {
+			slog.ErrorContext(ctx, "Failed to clear awaiting uncordon condition for manually uncordoned node",
+				"node", nodeName, "error", err)
+			metrics.ProcessingErrors.WithLabelValues("set_awaiting_uncordon_condition_error").Inc()
+		}
+	}
 
 	// Cancel latest quarantining events (if eventWatcher is available)
 	if r.eventWatcher != nil {
@@ -1712,7 +2067,7 @@ func (r *Reconciler) handleManualUntaint(nodeName string) error {
 		nodeName,
 		annotationsToRemove,
 		newAnnotations,
-		[]string{statemanager.NVSentinelStateLabelKey},
+		[]string{statemanager.NVSentinelStateLabelKey, r.cordonLabelKey},
 	); err != nil {
 		slog.ErrorContext(ctx, "Failed to clean up manually untainted node", "node", nodeName, "error", err)
 		metrics.ProcessingErrors.WithLabelValues("manual_untaint_cleanup_error").Inc()
@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -26,6 +27,7 @@ import (
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -311,6 +313,7 @@ func setupE2EReconcilerWithOptions(t *testing.T, ctx context.Context, cfg E2ERec
 
 	fqClient.NodeInformer.SetOnManualUncordonCallback(r.handleManualUncordon)
 	fqClient.NodeInformer.SetOnManualUntaintCallback(r.handleManualUntaint)
+	fqClient.NodeInformer.SetOnUncordonApprovedCallback(r.handleUncordonApproval)
 
 	stopCh := make(chan struct{})
 	t.Cleanup(func() { close(stopCh) })
@@ -361,6 +364,9 @@ func setupE2EReconcilerWithOptions(t *testing.T, ctx context.Context, cfg E2ERec
 		return r.ProcessEvent(ctx, event, ruleSetEvals, rulesetsConfig)
 	}
 
+	go r.runDeferredQuarantineRetries(ctx, processEventFunc)
+	go r.runPendingVerificationRetries(ctx, processEventFunc)
+
 	// Start event processing goroutine (mimics production event watcher)
 	go func() {
 		for event := range mockWatcher.Events() {
@@ -623,6 +629,7 @@ type MockEventWatcher struct {
 	CancelLatestQuarantiningEventsFn func(ctx context.Context, nodeName string, reason string) error
 	ProcessEventCallbackFn           func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status
 	StartFn                          func(ctx context.Context) error
+	UpdateNodeQuarantineStatusFn     func(ctx context.Context, eventID string, status *model.Status) error
 }
 
 func (m *MockEventWatcher) Start(ctx context.Context) error {
@@ -646,6 +653,13 @@ func (m *MockEventWatcher) CancelLatestQuarantiningEvents(ctx context.Context, n
 	return nil
 }
 
+func (m *MockEventWatcher) UpdateNodeQuarantineStatus(ctx context.Context, eventID string, status *model.Status) error {
+	if m.UpdateNodeQuarantineStatusFn != nil {
+		return m.UpdateNodeQuarantineStatusFn(ctx, eventID, status)
+	}
+	return nil
+}
+
 func TestE2E_BasicQuarantineAndUnquarantine(t *testing.T) {
 	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
 	defer cancel()
@@ -2389,6 +2403,399 @@ func TestE2E_NodeAlreadyQuarantinedBecomesHealthy(t *testing.T) {
 	assert.Empty(t, node.Annotations[common.QuarantineHealthEventIsCordonedAnnotationKey], "Cordoned annotation should be removed")
 }
 
+// createRemediatedE2ETestNode creates a node quarantined for GpuHealthCheck on GPU 0 that has
+// since been remediated, reporting the GpuHealthCheck condition with the given status.
+func createRemediatedE2ETestNode(ctx context.Context, t *testing.T, nodeName string, conditionStatus corev1.ConditionStatus) {
+	t.Helper()
+
+	existingMap := healthEventsAnnotation.NewHealthEventsAnnotationMap()
+	existingMap.AddOrUpdateEvent(&protos.HealthEvent{
+		NodeName:       nodeName,
+		Agent:          "gpu-health-monitor",
+		CheckName:      "GpuHealthCheck",
+		ComponentClass: "GPU",
+		Version:        1,
+		IsHealthy:      false,
+		EntitiesImpacted: []*protos.Entity{
+			{EntityType: "GPU", EntityValue: "0"},
+		},
+	})
+	existingBytes, err := json.Marshal(existingMap)
+	require.NoError(t, err)
+
+	annotations := map[string]string{
+		common.QuarantineHealthEventAnnotationKey:           string(existingBytes),
+		common.QuarantineHealthEventIsCordonedAnnotationKey: "True",
+	}
+	labels := map[string]string{
+		statemanager.NVSentinelStateLabelKey: string(statemanager.RemediationSucceededLabelValue),
+		"k8s.nvidia.com/cordon-timestamp":    time.Now().Add(-time.Hour).UTC().Format("2006-01-02T15-04-05Z"),
+	}
+
+	createE2ETestNode(ctx, t, nodeName, annotations, labels, nil, true)
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{
+		Type:              "GpuHealthCheck",
+		Status:            conditionStatus,
+		LastHeartbeatTime: metav1.Now(),
+	})
+	_, err = e2eTestClient.CoreV1().Nodes().UpdateStatus(ctx, node, metav1.UpdateOptions{})
+	require.NoError(t, err)
+}
+
+func TestE2E_PostRemediationVerificationPasses(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-post-rem-pass-" + generateShortTestID()
+	createRemediatedE2ETestNode(ctx, t, nodeName, corev1.ConditionFalse)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix: "k8s.nvidia.com/",
+		PostRemediationVerification: config.PostRemediationVerification{
+			Enabled:                   true,
+			RequiredHealthyConditions: []string{"GpuHealthCheck"},
+		},
+	}
+
+	_, mockWatcher, _, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+
+	t.Log("Send healthy event - remediated node reports healthy condition, should unquarantine")
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		generateTestID(),
+		nodeName,
+		"GpuHealthCheck",
+		true,
+		false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+
+		return !node.Spec.Unschedulable && node.Annotations[common.QuarantineHealthEventAnnotationKey] == ""
+	}, eventuallyTimeout, eventuallyPollInterval, "Node should be unquarantined after verification passes")
+}
+
+func TestE2E_PostRemediationVerificationFails(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-post-rem-fail-" + generateShortTestID()
+	createRemediatedE2ETestNode(ctx, t, nodeName, corev1.ConditionTrue)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix: "k8s.nvidia.com/",
+		PostRemediationVerification: config.PostRemediationVerification{
+			Enabled:                   true,
+			RequiredHealthyConditions: []string{"GpuHealthCheck"},
+		},
+	}
+
+	_, mockWatcher, _, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+
+	t.Log("Send healthy event - remediated node still reports failing condition, should stay quarantined")
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		generateTestID(),
+		nodeName,
+		"GpuHealthCheck",
+		true,
+		false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	assert.Never(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+
+		return !node.Spec.Unschedulable || node.Annotations[common.QuarantineHealthEventAnnotationKey] == ""
+	}, neverTimeout, neverPollInterval, "Node should remain quarantined while verification fails")
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	verifyHealthEventInAnnotation(t, node, "GpuHealthCheck", "gpu-health-monitor", "GPU", "GPU", "0")
+}
+
+func hasAwaitingUncordonCondition(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == common.AwaitingUncordonConditionType && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestE2E_UncordonPolicyAutomatic(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-uncordon-auto-" + generateShortTestID()
+	createRemediatedE2ETestNode(ctx, t, nodeName, corev1.ConditionFalse)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix:    "k8s.nvidia.com/",
+		UncordonPolicy: config.UncordonPolicyAutomatic,
+	}
+
+	_, mockWatcher, _, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+
+	t.Log("Send healthy event - node should be uncordoned immediately")
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		generateTestID(),
+		nodeName,
+		"GpuHealthCheck",
+		true,
+		false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+
+		return !node.Spec.Unschedulable && !hasAwaitingUncordonCondition(node)
+	}, eventuallyTimeout, eventuallyPollInterval, "Node should be uncordoned without approval")
+}
+
+func TestE2E_UncordonPolicyManual(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-uncordon-manual-" + generateShortTestID()
+	createRemediatedE2ETestNode(ctx, t, nodeName, corev1.ConditionFalse)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix:    "k8s.nvidia.com/",
+		UncordonPolicy: config.UncordonPolicyManual,
+	}
+
+	_, mockWatcher, _, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+
+	t.Log("Send healthy event - node should stay cordoned and await approval")
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		generateTestID(),
+		nodeName,
+		"GpuHealthCheck",
+		true,
+		false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+
+		return hasAwaitingUncordonCondition(node)
+	}, eventuallyTimeout, eventuallyPollInterval, "Node should report the AwaitingUncordon condition")
+
+	assert.Never(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+
+		return !node.Spec.Unschedulable
+	}, neverTimeout, neverPollInterval, "Node should remain cordoned until uncordon is approved")
+
+	t.Log("Approve the uncordon - node should be uncordoned and the condition cleared")
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	node.Annotations[common.QuarantinedNodeUncordonApprovedAnnotationKey] =
+		common.QuarantinedNodeUncordonApprovedAnnotationValue
+	_, err = e2eTestClient.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+
+		_, stillApproved := node.Annotations[common.QuarantinedNodeUncordonApprovedAnnotationKey]
+
+		return !node.Spec.Unschedulable &&
+			!hasAwaitingUncordonCondition(node) &&
+			!stillApproved &&
+			node.Annotations[common.QuarantineHealthEventAnnotationKey] == ""
+	}, eventuallyTimeout, eventuallyPollInterval, "Node should be uncordoned after approval")
+}
+
+type fakePreflightRunner struct {
+	passed atomic.Bool
+	err    error
+	calls  atomic.Int32
+}
+
+func (f *fakePreflightRunner) RunPreflight(ctx context.Context, nodeName string) (bool, error) {
+	f.calls.Add(1)
+	return f.passed.Load(), f.err
+}
+
+func TestE2E_PostRemediationPreflight(t *testing.T) {
+	tests := []struct {
+		name             string
+		preflightPassed  bool
+		preflightErr     error
+		expectUncordoned bool
+	}{
+		{name: "preflight passes", preflightPassed: true, expectUncordoned: true},
+		{name: "preflight fails", preflightPassed: false, expectUncordoned: false},
+		{name: "preflight errors", preflightErr: errors.New("preflight pod failed to start"), expectUncordoned: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+			defer cancel()
+
+			nodeName := "e2e-post-rem-preflight-" + generateShortTestID()
+			createRemediatedE2ETestNode(ctx, t, nodeName, corev1.ConditionFalse)
+			defer func() {
+				_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+			}()
+
+			tomlConfig := config.TomlConfig{
+				LabelPrefix: "k8s.nvidia.com/",
+				PostRemediationVerification: config.PostRemediationVerification{
+					Enabled:                   true,
+					RequiredHealthyConditions: []string{"GpuHealthCheck"},
+				},
+			}
+
+			r, mockWatcher, _, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+			runner := &fakePreflightRunner{err: tt.preflightErr}
+			runner.passed.Store(tt.preflightPassed)
+			r.SetPreflightRunner(runner)
+
+			mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+				generateTestID(),
+				nodeName,
+				"GpuHealthCheck",
+				true,
+				false,
+				[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+				model.StatusInProgress,
+			)}
+
+			require.Eventually(t, func() bool {
+				return runner.calls.Load() > 0
+			}, eventuallyTimeout, eventuallyPollInterval, "Preflight runner should be called for remediated node")
+
+			isQuarantined := func() bool {
+				node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+				if err != nil {
+					return true
+				}
+
+				return node.Spec.Unschedulable || node.Annotations[common.QuarantineHealthEventAnnotationKey] != ""
+			}
+
+			if tt.expectUncordoned {
+				require.Eventually(t, func() bool { return !isQuarantined() },
+					eventuallyTimeout, eventuallyPollInterval, "Node should be unquarantined after preflight passes")
+			} else {
+				assert.Never(t, func() bool { return !isQuarantined() },
+					neverTimeout, neverPollInterval, "Node should remain quarantined while preflight fails")
+			}
+		})
+	}
+}
+
+func TestE2E_PostRemediationVerificationRetried(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-post-rem-retry-" + generateShortTestID()
+	createRemediatedE2ETestNode(ctx, t, nodeName, corev1.ConditionFalse)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix: "k8s.nvidia.com/",
+		PostRemediationVerification: config.PostRemediationVerification{
+			Enabled:                   true,
+			RequiredHealthyConditions: []string{"GpuHealthCheck"},
+		},
+	}
+
+	r, mockWatcher, _, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+	runner := &fakePreflightRunner{}
+	r.SetPreflightRunner(runner)
+
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		generateTestID(),
+		nodeName,
+		"GpuHealthCheck",
+		true,
+		false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	getNode := func() *corev1.Node {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		require.NoError(t, err)
+
+		return node
+	}
+
+	require.Eventually(t, func() bool {
+		return getNode().Annotations[common.PostRemediationVerificationPendingAnnotationKey] != ""
+	}, eventuallyTimeout, eventuallyPollInterval, "Failed verification should be recorded for retry")
+	assert.True(t, getNode().Spec.Unschedulable, "Node should remain cordoned while verification fails")
+
+	ruleSetEvals, err := r.initializeRuleSetEvaluators()
+	require.NoError(t, err)
+
+	rulesetsConfig := r.buildRulesetsConfig()
+	process := func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status {
+		return r.ProcessEvent(ctx, event, ruleSetEvals, rulesetsConfig)
+	}
+
+	runner.passed.Store(true)
+	r.retryPendingVerifications(ctx, process)
+
+	require.Eventually(t, func() bool {
+		node := getNode()
+
+		return !node.Spec.Unschedulable &&
+			node.Annotations[common.QuarantineHealthEventAnnotationKey] == "" &&
+			node.Annotations[common.PostRemediationVerificationPendingAnnotationKey] == ""
+	}, eventuallyTimeout, eventuallyPollInterval, "Node should be uncordoned once a retried verification passes")
+}
+
 func TestE2E_RulesetNotMatching(t *testing.T) {
 	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
 	defer cancel()
@@ -2899,6 +3306,577 @@ func TestE2E_CircuitBreakerUniqueNodeTracking(t *testing.T) {
 	assert.True(t, isTripped, "Circuit breaker should trip with 5 unique nodes (50%)")
 }
 
+func TestE2E_QuarantineBudget(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 30*time.Second)
+	defer cancel()
+
+	// Create 10 test nodes
+	baseNodeName := "e2e-budget-" + generateShortTestID()[:6]
+	for i := 0; i < 10; i++ {
+		nodeName := fmt.Sprintf("%s-%d", baseNodeName, i)
+		createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+		defer func(name string) {
+			_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, name, metav1.DeleteOptions{})
+		}(nodeName)
+	}
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix:      "k8s.nvidia.com/",
+		QuarantineBudget: config.QuarantineBudget{MaxNodes: 3},
+		RuleSets: []config.RuleSet{
+			{
+				Enabled:  true,
+				Name:     "gpu-errors",
+				Version:  "1",
+				Priority: 10,
+				Match: config.Match{
+					Any: []config.Rule{
+						{Kind: "HealthEvent", Expression: "true"},
+					},
+				},
+				Taint:  config.Taint{Key: "nvidia.com/gpu-error", Value: "true", Effect: "NoSchedule"},
+				Cordon: config.Cordon{ShouldCordon: true},
+			},
+		},
+	}
+
+	// Circuit breaker disabled so only the budget limits quarantines
+	r, mockWatcher, getStatus, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+
+	require.Eventually(t, func() bool {
+		totalNodes, _, err := r.k8sClient.NodeInformer.GetNodeCounts()
+		return err == nil && totalNodes == 10
+	}, statusCheckTimeout, statusCheckPollInterval, "NodeInformer should see all 10 nodes")
+
+	t.Log("Quarantining 3 nodes - within budget")
+	for i := 0; i < 3; i++ {
+		nodeName := fmt.Sprintf("%s-%d", baseNodeName, i)
+		mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+			generateTestID(),
+			nodeName,
+			"TestCheck",
+			false,
+			true,
+			[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+			model.StatusInProgress,
+		)}
+
+		// Wait for the informer to see the quarantine so the next budget check counts it
+		expected := i + 1
+		require.Eventually(t, func() bool {
+			_, quarantined, err := r.k8sClient.NodeInformer.GetNodeCounts()
+			return err == nil && len(quarantined) == expected
+		}, statusCheckTimeout, statusCheckPollInterval, "node %s should be quarantined", nodeName)
+	}
+
+	t.Log("Quarantining 4th node - should be deferred by the budget")
+	deferredNode := fmt.Sprintf("%s-3", baseNodeName)
+	deferredEventID := generateTestID()
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		deferredEventID,
+		deferredNode,
+		"TestCheck",
+		false,
+		true,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		return getCounterVecValue(t, metrics.QuarantinesDeferredByBudget, deferredNode) == 1
+	}, statusCheckTimeout, statusCheckPollInterval, "deferred quarantine should be counted")
+
+	assert.Nil(t, getStatus(deferredEventID), "deferred event should not get a quarantine status")
+
+	gauge := &dto.Metric{}
+	require.NoError(t, metrics.QuarantineBudgetExhausted.Write(gauge))
+	assert.Equal(t, float64(1), gauge.GetGauge().GetValue(), "budget should be reported as exhausted")
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, deferredNode, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, node.Spec.Unschedulable, "4th node should not be cordoned")
+	assert.Empty(t, node.Annotations[quarantineHealthEventAnnotationKey], "4th node should not be annotated")
+	assert.NotEmpty(t, node.Annotations[common.QuarantineDeferredHealthEventAnnotationKey],
+		"4th node should record the deferred quarantine")
+
+	_, quarantined, err := r.k8sClient.NodeInformer.GetNodeCounts()
+	require.NoError(t, err)
+	assert.Len(t, quarantined, 3, "quarantines should stop at the budget")
+
+	var statusMu sync.Mutex
+	var retriedStatus *model.Status
+	r.SetEventWatcher(&MockEventWatcher{
+		UpdateNodeQuarantineStatusFn: func(_ context.Context, _ string, status *model.Status) error {
+			statusMu.Lock()
+			defer statusMu.Unlock()
+			retriedStatus = status
+			return nil
+		},
+	})
+
+	t.Log("Releasing a quarantined node - the deferred quarantine should be retried")
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		generateTestID(),
+		fmt.Sprintf("%s-0", baseNodeName),
+		"TestCheck",
+		true,
+		false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}},
+		model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, deferredNode, metav1.GetOptions{})
+		return err == nil && node.Spec.Unschedulable &&
+			node.Annotations[quarantineHealthEventAnnotationKey] != "" &&
+			node.Annotations[common.QuarantineDeferredHealthEventAnnotationKey] == ""
+	}, statusCheckTimeout, statusCheckPollInterval, "deferred node should be quarantined once the budget has room")
+
+	require.Eventually(t, func() bool {
+		statusMu.Lock()
+		defer statusMu.Unlock()
+		return retriedStatus != nil && *retriedStatus == model.Quarantined
+	}, statusCheckTimeout, statusCheckPollInterval, "retried quarantine should record its status")
+
+	require.Eventually(t, func() bool {
+		_, quarantined, err := r.k8sClient.NodeInformer.GetNodeCounts()
+		return err == nil && len(quarantined) == 3 && quarantined[deferredNode]
+	}, statusCheckTimeout, statusCheckPollInterval, "quarantines should stay within the budget")
+}
+
+func TestE2E_CordonLabelGatesUncordon(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 30*time.Second)
+	defer cancel()
+
+	fqNodeName := "e2e-cordon-label-fq-" + generateShortTestID()
+	createE2ETestNode(ctx, t, fqNodeName, nil, nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, fqNodeName, metav1.DeleteOptions{})
+	}()
+
+	// Cordoned by an operator (kubectl cordon) before any health event arrives
+	manualNodeName := "e2e-cordon-label-manual-" + generateShortTestID()
+	createE2ETestNode(ctx, t, manualNodeName, nil, nil, nil, true)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, manualNodeName, metav1.DeleteOptions{})
+	}()
+
+	const cordonLabelKey = "example.com/nvsentinel-cordon"
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix:    "k8s.nvidia.com/",
+		CordonLabelKey: cordonLabelKey,
+		RuleSets: []config.RuleSet{
+			{
+				Enabled:  true,
+				Name:     "gpu-xid-critical-errors",
+				Version:  "1",
+				Priority: 10,
+				Match: config.Match{
+					Any: []config.Rule{
+						{Kind: "HealthEvent", Expression: "event.checkName == 'GpuXidError' && event.isFatal == true"},
+					},
+				},
+				Cordon: config.Cordon{ShouldCordon: true},
+			},
+		},
+	}
+
+	r, mockWatcher, getStatus, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+	require.Equal(t, cordonLabelKey, r.cordonLabelKey, "Configured cordon label key should be used")
+	r.k8sClient.SetCordonLabelKey(r.cordonLabelKey)
+
+	for _, nodeName := range []string{fqNodeName, manualNodeName} {
+		mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+			generateTestID(), nodeName, "GpuXidError", false, true,
+			[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}}, model.StatusInProgress,
+		)}
+	}
+
+	t.Log("Waiting for both nodes to be quarantined")
+	require.Eventually(t, func() bool {
+		for _, nodeName := range []string{fqNodeName, manualNodeName} {
+			node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+			if err != nil || node.Annotations[common.QuarantineHealthEventAnnotationKey] == "" {
+				return false
+			}
+		}
+		return true
+	}, eventuallyTimeout, eventuallyPollInterval, "Both nodes should be quarantined")
+
+	fqNode, err := e2eTestClient.CoreV1().Nodes().Get(ctx, fqNodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, fqNode.Spec.Unschedulable)
+	assert.NotEmpty(t, fqNode.Labels[cordonLabelKey], "Node cordoned by NVSentinel should carry the cordon label")
+	assert.NotContains(t, fqNode.Labels, "k8s.nvidia.com/cordon-event", "Default cordon label key should not be used")
+
+	manualNode, err := e2eTestClient.CoreV1().Nodes().Get(ctx, manualNodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, manualNode.Labels, cordonLabelKey, "Manually cordoned node should not carry the cordon label")
+
+	t.Log("Sending healthy events for both nodes")
+	healthyEventIDs := map[string]string{}
+	for _, nodeName := range []string{fqNodeName, manualNodeName} {
+		healthyEventIDs[nodeName] = generateTestID()
+		mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+			healthyEventIDs[nodeName], nodeName, "GpuXidError", true, false,
+			[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}}, model.StatusInProgress,
+		)}
+	}
+
+	require.Eventually(t, func() bool {
+		for _, eventID := range healthyEventIDs {
+			status := getStatus(eventID)
+			if status == nil || *status != model.UnQuarantined {
+				return false
+			}
+		}
+		return true
+	}, statusCheckTimeout, statusCheckPollInterval, "Both healthy events should be processed")
+
+	t.Log("Node cordoned by NVSentinel is uncordoned and its cordon label removed")
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, fqNodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		_, hasLabel := node.Labels[cordonLabelKey]
+		return !node.Spec.Unschedulable && !hasLabel
+	}, eventuallyTimeout, eventuallyPollInterval, "NVSentinel-cordoned node should be uncordoned")
+
+	t.Log("Manually cordoned node stays cordoned")
+	manualNode, err = e2eTestClient.CoreV1().Nodes().Get(ctx, manualNodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, manualNode.Spec.Unschedulable, "Manually cordoned node should remain cordoned")
+	assert.Empty(t, manualNode.Annotations[common.QuarantineHealthEventAnnotationKey])
+}
+
+// TestE2E_CordonLabelGateDisabledByDefault covers upgrades: nodes quarantined by a release without
+// the cordon label must still be uncordoned when the label key is not configured.
+func TestE2E_CordonLabelGateDisabledByDefault(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 30*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-cordon-label-upgrade-" + generateShortTestID()
+	createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix: "k8s.nvidia.com/",
+		RuleSets: []config.RuleSet{
+			{
+				Enabled:  true,
+				Name:     "gpu-xid-critical-errors",
+				Version:  "1",
+				Priority: 10,
+				Match: config.Match{
+					Any: []config.Rule{
+						{Kind: "HealthEvent", Expression: "event.checkName == 'GpuXidError' && event.isFatal == true"},
+					},
+				},
+				Cordon: config.Cordon{ShouldCordon: true},
+			},
+		},
+	}
+
+	r, mockWatcher, getStatus, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+	require.Empty(t, r.cordonLabelKey, "Cordon label gate should be disabled unless configured")
+
+	unhealthyEventID := generateTestID()
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		unhealthyEventID, nodeName, "GpuXidError", false, true,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}}, model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		status := getStatus(unhealthyEventID)
+		return status != nil && *status == model.Quarantined
+	}, statusCheckTimeout, statusCheckPollInterval, "Node should be quarantined")
+
+	t.Log("Node is quarantined the way the previous release did it, without a cordon label")
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, node.Spec.Unschedulable)
+	assert.NotContains(t, node.Labels, "k8s.nvidia.com/cordon-event")
+	assert.Equal(t, common.ServiceName, node.Labels["k8s.nvidia.com/cordon-by"])
+
+	healthyEventID := generateTestID()
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		healthyEventID, nodeName, "GpuXidError", true, false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}}, model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		status := getStatus(healthyEventID)
+		return status != nil && *status == model.UnQuarantined
+	}, statusCheckTimeout, statusCheckPollInterval, "Healthy event should unquarantine the node")
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		return !node.Spec.Unschedulable && node.Annotations[common.QuarantineHealthEventAnnotationKey] == ""
+	}, eventuallyTimeout, eventuallyPollInterval, "Node without a cordon label should be uncordoned")
+}
+
+func TestE2E_WarningPhaseEscalatesToQuarantine(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 30*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-warning-" + generateShortTestID()
+	createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix:  "k8s.nvidia.com/",
+		WarningPhase: config.WarningPhase{Enabled: true},
+		RuleSets: []config.RuleSet{
+			{
+				Enabled:  true,
+				Name:     "gpu-xid-critical-errors",
+				Version:  "1",
+				Priority: 10,
+				Match: config.Match{
+					Any: []config.Rule{
+						{Kind: "HealthEvent", Expression: "event.checkName == 'GpuXidError' && event.isFatal == true"},
+					},
+				},
+				Taint:  config.Taint{Key: "nvidia.com/gpu-xid-error", Value: "true", Effect: "NoSchedule"},
+				Cordon: config.Cordon{ShouldCordon: true},
+			},
+		},
+	}
+
+	_, mockWatcher, getStatus, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+
+	beforeWarnings := getCounterVecValue(t, metrics.WarningEvents, nodeName, "GpuXidError")
+
+	t.Log("Sending warning-severity (non-fatal) event")
+	warningEventID := generateTestID()
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		warningEventID, nodeName, "GpuXidError", false, false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}}, model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		return node.Labels[statemanager.NVSentinelStateLabelKey] == string(statemanager.WarningLabelValue)
+	}, eventuallyTimeout, eventuallyPollInterval, "Node should enter the warning phase")
+
+	node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, node.Spec.Unschedulable, "Warning phase should not cordon the node")
+	assert.Empty(t, node.Spec.Taints, "Warning phase should not taint the node")
+	assert.Empty(t, node.Annotations[common.QuarantineHealthEventAnnotationKey])
+	assert.Equal(t, `["GpuXidError"]`, node.Annotations[common.WarningHealthEventsAnnotationKey])
+	assert.Nil(t, getStatus(warningEventID), "Warning events should not be propagated downstream")
+	assert.Equal(t, beforeWarnings+1, getCounterVecValue(t, metrics.WarningEvents, nodeName, "GpuXidError"))
+	assert.Equal(t, float64(1), getGaugeVecValue(t, metrics.CurrentWarningNodes, nodeName))
+
+	t.Log("Sending critical (fatal) event to escalate")
+	criticalEventID := generateTestID()
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		criticalEventID, nodeName, "GpuXidError", false, true,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}}, model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		status := getStatus(criticalEventID)
+		return status != nil && *status == model.Quarantined
+	}, statusCheckTimeout, statusCheckPollInterval, "Critical event should quarantine the node")
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		return node.Spec.Unschedulable &&
+			node.Labels[statemanager.NVSentinelStateLabelKey] == string(statemanager.QuarantinedLabelValue)
+	}, eventuallyTimeout, eventuallyPollInterval, "Node should escalate from warning to quarantined")
+
+	assert.Equal(t, float64(0), getGaugeVecValue(t, metrics.CurrentWarningNodes, nodeName))
+}
+
+func TestE2E_WarningPhaseClearsOnRecovery(t *testing.T) {
+	ctx, cancel := context.WithTimeout(e2eTestContext, 30*time.Second)
+	defer cancel()
+
+	nodeName := "e2e-warning-clear-" + generateShortTestID()
+	createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+	defer func() {
+		_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	tomlConfig := config.TomlConfig{
+		LabelPrefix:  "k8s.nvidia.com/",
+		WarningPhase: config.WarningPhase{Enabled: true},
+		RuleSets: []config.RuleSet{
+			{
+				Enabled:  true,
+				Name:     "gpu-xid-critical-errors",
+				Version:  "1",
+				Priority: 10,
+				Match: config.Match{
+					Any: []config.Rule{
+						{Kind: "HealthEvent", Expression: "event.checkName == 'GpuXidError' && event.isFatal == true"},
+					},
+				},
+				Cordon: config.Cordon{ShouldCordon: true},
+			},
+		},
+	}
+
+	_, mockWatcher, _, _ := setupE2EReconciler(t, ctx, tomlConfig, nil)
+
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		generateTestID(), nodeName, "GpuXidError", false, false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}}, model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		return node.Labels[statemanager.NVSentinelStateLabelKey] == string(statemanager.WarningLabelValue)
+	}, eventuallyTimeout, eventuallyPollInterval, "Node should enter the warning phase")
+
+	mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+		generateTestID(), nodeName, "GpuXidError", true, false,
+		[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}}, model.StatusInProgress,
+	)}
+
+	require.Eventually(t, func() bool {
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		_, hasState := node.Labels[statemanager.NVSentinelStateLabelKey]
+		_, hasWarning := node.Annotations[common.WarningHealthEventsAnnotationKey]
+		return !hasState && !hasWarning
+	}, eventuallyTimeout, eventuallyPollInterval, "Healthy event should clear the warning phase")
+}
+
+func TestE2E_ClusterScopedEvents(t *testing.T) {
+	newTomlConfig := func(enabled bool) config.TomlConfig {
+		return config.TomlConfig{
+			LabelPrefix:         "k8s.nvidia.com/",
+			ClusterScopedEvents: config.ClusterScopedEvents{Enabled: enabled},
+			RuleSets: []config.RuleSet{
+				{
+					Enabled:  true,
+					Name:     "fatal-errors",
+					Version:  "1",
+					Priority: 10,
+					Match: config.Match{
+						Any: []config.Rule{
+							{Kind: "HealthEvent", Expression: "event.isFatal == true"},
+						},
+					},
+					Taint:  config.Taint{Key: "nvidia.com/fatal-error", Value: "true", Effect: "NoSchedule"},
+					Cordon: config.Cordon{ShouldCordon: true},
+				},
+			},
+		}
+	}
+
+	assertNodeUntouched := func(t *testing.T, ctx context.Context, nodeName string) {
+		t.Helper()
+
+		node, err := e2eTestClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.False(t, node.Spec.Unschedulable, "Cluster-scoped events should not cordon any node")
+		assert.Empty(t, node.Spec.Taints, "Cluster-scoped events should not taint any node")
+		assert.Empty(t, node.Annotations[common.QuarantineHealthEventAnnotationKey])
+	}
+
+	t.Run("enabled routes to alerting and records status", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+		defer cancel()
+
+		nodeName := "e2e-cluster-scoped-" + generateShortTestID()
+		createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+		defer func() {
+			_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+		}()
+
+		_, mockWatcher, getStatus, _ := setupE2EReconciler(t, ctx, newTomlConfig(true), nil)
+
+		beforeAlerts := getCounterVecValue(t, metrics.ClusterScopedEvents, "gpu-health-monitor", "FabricManagerDown")
+
+		t.Log("Sending fatal event without a node name")
+		unhealthyEventID := generateTestID()
+		mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+			unhealthyEventID, "", "FabricManagerDown", false, true, nil, model.StatusInProgress,
+		)}
+
+		require.Eventually(t, func() bool {
+			status := getStatus(unhealthyEventID)
+			return status != nil && *status == model.ClusterScoped
+		}, statusCheckTimeout, statusCheckPollInterval, "Cluster-scoped event should be recorded as ClusterScoped")
+
+		assert.Equal(t, beforeAlerts+1,
+			getCounterVecValue(t, metrics.ClusterScopedEvents, "gpu-health-monitor", "FabricManagerDown"))
+		assertNodeUntouched(t, ctx, nodeName)
+
+		t.Log("Sending healthy event without a node name")
+		healthyEventID := generateTestID()
+		mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+			healthyEventID, "", "FabricManagerDown", true, false, nil, model.StatusInProgress,
+		)}
+
+		require.Eventually(t, func() bool {
+			status := getStatus(healthyEventID)
+			return status != nil && *status == model.ClusterScoped
+		}, statusCheckTimeout, statusCheckPollInterval, "Cluster-scoped recovery should be recorded as ClusterScoped")
+
+		assert.Equal(t, beforeAlerts+1,
+			getCounterVecValue(t, metrics.ClusterScopedEvents, "gpu-health-monitor", "FabricManagerDown"),
+			"Healthy cluster-scoped events should not raise an alert")
+	})
+
+	t.Run("disabled skips the event", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
+		defer cancel()
+
+		nodeName := "e2e-cluster-scoped-off-" + generateShortTestID()
+		createE2ETestNode(ctx, t, nodeName, nil, nil, nil, false)
+		defer func() {
+			_ = e2eTestClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+		}()
+
+		_, mockWatcher, getStatus, _ := setupE2EReconciler(t, ctx, newTomlConfig(false), nil)
+
+		clusterEventID := generateTestID()
+		mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+			clusterEventID, "", "FabricManagerDown", false, true, nil, model.StatusInProgress,
+		)}
+
+		// Events are processed in order, so once the node event is quarantined the
+		// cluster-scoped event before it has been handled.
+		nodeEventID := generateTestID()
+		mockWatcher.EventsChan <- &TestEvent{Data: createHealthEventBSON(
+			nodeEventID, nodeName, "GpuXidError", false, true,
+			[]*protos.Entity{{EntityType: "GPU", EntityValue: "0"}}, model.StatusInProgress,
+		)}
+
+		require.Eventually(t, func() bool {
+			status := getStatus(nodeEventID)
+			return status != nil && *status == model.Quarantined
+		}, statusCheckTimeout, statusCheckPollInterval, "Node event should still be quarantined")
+
+		assert.Nil(t, getStatus(clusterEventID), "Cluster-scoped event should be skipped when disabled")
+	})
+}
+
 func TestE2E_QuarantineOverridesForce(t *testing.T) {
 	ctx, cancel := context.WithTimeout(e2eTestContext, 20*time.Second)
 	defer cancel()
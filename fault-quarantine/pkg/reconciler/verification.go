@@ -0,0 +1,141 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/metrics"
+)
+
+// pendingVerificationRetryInterval is how often nodes that failed post-remediation verification
+// are verified again.
+const pendingVerificationRetryInterval = time.Minute
+
+// recordPendingVerification records the healthy event that failed post-remediation verification
+// on its node, so that verification is retried without waiting for another healthy event. A later
+// failing event for the same node replaces the earlier one.
+func (r *Reconciler) recordPendingVerification(ctx context.Context, event *protos.HealthEvent) {
+	eventJSON, err := json.Marshal(event)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to marshal pending verification health event", "node", event.NodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("pending_verification_error").Inc()
+
+		return
+	}
+
+	err = r.k8sClient.UpdateNode(ctx, event.NodeName, func(node *v1.Node) error {
+		if node.Annotations == nil {
+			node.Annotations = map[string]string{}
+		}
+
+		node.Annotations[common.PostRemediationVerificationPendingAnnotationKey] = string(eventJSON)
+
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to record pending verification on node", "node", event.NodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("pending_verification_error").Inc()
+	}
+}
+
+// clearPendingVerification removes the node's pending verification, or only the pending
+// verification of failedCheck when it is set, since the check failing again means its recorded
+// healthy event is stale.
+func (r *Reconciler) clearPendingVerification(ctx context.Context, nodeName, failedCheck string) {
+	node, err := r.k8sClient.NodeInformer.GetNode(nodeName)
+	if err != nil || node.Annotations[common.PostRemediationVerificationPendingAnnotationKey] == "" {
+		return
+	}
+
+	err = r.k8sClient.UpdateNode(ctx, nodeName, func(node *v1.Node) error {
+		if failedCheck != "" {
+			pending, err := annotatedHealthEvent(node, common.PostRemediationVerificationPendingAnnotationKey)
+			if err == nil && pending != nil && pending.CheckName != failedCheck {
+				return nil
+			}
+		}
+
+		delete(node.Annotations, common.PostRemediationVerificationPendingAnnotationKey)
+
+		return nil
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to clear pending verification on node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("pending_verification_error").Inc()
+	}
+}
+
+// runPendingVerificationRetries periodically reprocesses the healthy events of nodes that failed
+// post-remediation verification, so that a node is uncordoned once it verifies even if no further
+// healthy event arrives.
+func (r *Reconciler) runPendingVerificationRetries(
+	ctx context.Context,
+	process func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status,
+) {
+	ticker := time.NewTicker(pendingVerificationRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		r.retryPendingVerifications(ctx, process)
+	}
+}
+
+// retryPendingVerifications reprocesses each pending healthy event through the normal processing
+// path, which verifies the node again and records the event again if verification still fails.
+func (r *Reconciler) retryPendingVerifications(
+	ctx context.Context,
+	process func(ctx context.Context, event *model.HealthEventWithStatus) *model.Status,
+) {
+	nodes, err := r.k8sClient.NodeInformer.ListNodes()
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to list nodes for pending verifications", "error", err)
+
+		return
+	}
+
+	for _, node := range nodes {
+		event, err := annotatedHealthEvent(node, common.PostRemediationVerificationPendingAnnotationKey)
+		if err != nil {
+			slog.WarnContext(ctx, "Ignoring invalid pending verification", "node", node.Name, "error", err)
+
+			continue
+		}
+
+		if event == nil {
+			continue
+		}
+
+		slog.InfoContext(ctx, "Retrying post-remediation verification", "node", event.NodeName, "checkName", event.CheckName)
+
+		r.clearPendingVerification(ctx, event.NodeName, "")
+
+		r.reprocessHealthEvent(ctx, process, event)
+	}
+}
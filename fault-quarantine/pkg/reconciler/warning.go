@@ -0,0 +1,160 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-quarantine/pkg/metrics"
+)
+
+// isWarningEvent reports whether the event is degraded-but-usable: unhealthy but not fatal.
+// Fatal events are critical and go through quarantine rule evaluation only.
+func (r *Reconciler) isWarningEvent(event *model.HealthEventWithStatus) bool {
+	return r.config.TomlConfig.WarningPhase.Enabled && !event.HealthEvent.IsHealthy && !event.HealthEvent.IsFatal
+}
+
+// warningChecks returns the check names recorded in the node's warning annotation.
+func warningChecks(node *v1.Node) ([]string, error) {
+	value := node.Annotations[common.WarningHealthEventsAnnotationKey]
+	if value == "" {
+		return nil, nil
+	}
+
+	var checks []string
+	if err := json.Unmarshal([]byte(value), &checks); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal warning annotation for node %s: %w", node.Name, err)
+	}
+
+	return checks, nil
+}
+
+// setWarningChecks writes checks to the node's warning annotation and sets the warning state label.
+// An empty list clears both, unless the node has since moved on to another state.
+func setWarningChecks(node *v1.Node, checks []string) error {
+	state, hasState := node.Labels[statemanager.NVSentinelStateLabelKey]
+
+	if len(checks) == 0 {
+		delete(node.Annotations, common.WarningHealthEventsAnnotationKey)
+
+		if state == string(statemanager.WarningLabelValue) {
+			delete(node.Labels, statemanager.NVSentinelStateLabelKey)
+		}
+
+		return nil
+	}
+
+	checksJSON, err := json.Marshal(checks)
+	if err != nil {
+		return fmt.Errorf("failed to marshal warning annotation for node %s: %w", node.Name, err)
+	}
+
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+
+	node.Annotations[common.WarningHealthEventsAnnotationKey] = string(checksJSON)
+
+	if !hasState {
+		if node.Labels == nil {
+			node.Labels = map[string]string{}
+		}
+
+		node.Labels[statemanager.NVSentinelStateLabelKey] = string(statemanager.WarningLabelValue)
+	}
+
+	return nil
+}
+
+// enterWarningPhase records a warning event on a node that no quarantine rule matched. The node
+// is neither cordoned nor tainted; it is escalated to quarantine once an event matches a rule.
+func (r *Reconciler) enterWarningPhase(ctx context.Context, event *model.HealthEventWithStatus) {
+	nodeName := event.HealthEvent.NodeName
+	checkName := event.HealthEvent.CheckName
+
+	err := r.k8sClient.UpdateNode(ctx, nodeName, func(node *v1.Node) error {
+		checks, err := warningChecks(node)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(checks, checkName) {
+			checks = append(checks, checkName)
+			slices.Sort(checks)
+		}
+
+		return setWarningChecks(node, checks)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to record warning on node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("warning_phase_error").Inc()
+
+		return
+	}
+
+	metrics.WarningEvents.WithLabelValues(nodeName, checkName).Inc()
+	metrics.CurrentWarningNodes.WithLabelValues(nodeName).Set(1)
+
+	slog.WarnContext(ctx, "Node entered warning phase",
+		"node", nodeName,
+		"checkName", checkName,
+		"message", event.HealthEvent.Message)
+}
+
+// clearWarningPhase removes a recovered check from the node's warning annotation and leaves the
+// warning phase once no warning checks remain.
+func (r *Reconciler) clearWarningPhase(ctx context.Context, event *model.HealthEventWithStatus) {
+	nodeName := event.HealthEvent.NodeName
+	checkName := event.HealthEvent.CheckName
+
+	node, err := r.k8sClient.NodeInformer.GetNode(nodeName)
+	if err != nil || node.Annotations[common.WarningHealthEventsAnnotationKey] == "" {
+		return
+	}
+
+	cleared := false
+
+	err = r.k8sClient.UpdateNode(ctx, nodeName, func(node *v1.Node) error {
+		checks, err := warningChecks(node)
+		if err != nil {
+			return err
+		}
+
+		remaining := slices.DeleteFunc(checks, func(check string) bool { return check == checkName })
+		cleared = len(remaining) == 0
+
+		return setWarningChecks(node, remaining)
+	})
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to clear warning on node", "node", nodeName, "error", err)
+		metrics.ProcessingErrors.WithLabelValues("warning_phase_error").Inc()
+
+		return
+	}
+
+	if cleared {
+		metrics.CurrentWarningNodes.WithLabelValues(nodeName).Set(0)
+		slog.InfoContext(ctx, "Node left warning phase", "node", nodeName, "checkName", checkName)
+	}
+}
@@ -42,6 +42,7 @@ import (
 	metrics "github.com/nvidia/nvsentinel/commons/pkg/metrics"
 	"github.com/nvidia/nvsentinel/commons/pkg/tracing"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/initializer"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/snapshot"
 	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
@@ -53,6 +54,10 @@ func init() {
 var (
 	scheme = runtime.NewScheme()
 
+	// snapshotHandler serves the pipeline snapshot on the metrics endpoint once the
+	// datastore has been initialized.
+	snapshotHandler = &snapshot.Handler{}
+
 	// These variables will be populated during the build process
 	version = "dev"
 	commit  = "none"
@@ -69,6 +74,7 @@ var (
 	tomlConfigPath              string
 	dryRun                      bool
 	enableLogCollector          bool
+	enableDebugSnapshot         bool
 )
 
 func main() {
@@ -111,6 +117,7 @@ func run() error {
 	ff.Set("dry_run", dryRun)
 	ff.Set("leader_election", enableLeaderElection)
 	ff.Set("log_collector", enableLogCollector)
+	ff.Set("debug_snapshot", enableDebugSnapshot)
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -187,11 +194,17 @@ func createManager() (ctrl.Manager, error) {
 		return auditlogger.NewAuditingRoundTripper(rt)
 	})
 
+	metricsOptions := metricsserver.Options{BindAddress: metricsAddr}
+
+	// The snapshot is unauthenticated and exposes node and event details, so it is only
+	// served when explicitly enabled.
+	if enableDebugSnapshot {
+		metricsOptions.ExtraHandlers = map[string]http.Handler{snapshot.Path: snapshotHandler}
+	}
+
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
-		Scheme: scheme,
-		Metrics: metricsserver.Options{
-			BindAddress: metricsAddr,
-		},
+		Scheme:                  scheme,
+		Metrics:                 metricsOptions,
 		HealthProbeBindAddress:  healthAddr,
 		LeaderElection:          enableLeaderElection,
 		LeaseDuration:           &leaderElectionLeaseDuration,
@@ -234,6 +247,8 @@ func initializeAndWatch(
 
 	reconciler := components.FaultRemediationReconciler
 
+	snapshotHandler.SetCollector(snapshot.NewCollector(components.HealthEventStore, mgr.GetAPIReader()))
+
 	cleanup = func() {
 		closeCtx, cancel := context.WithTimeout(context.Background(), reconcilerCloseTimeout)
 		defer cancel()
@@ -329,5 +344,8 @@ func parseFlags() {
 	flag.BoolVar(&enableLogCollector, "enable-log-collector", false,
 		"enable log collector feature for gathering logs from affected nodes")
 
+	flag.BoolVar(&enableDebugSnapshot, "enable-debug-snapshot", false,
+		"serve the pipeline snapshot on "+snapshot.Path+" of the metrics port")
+
 	flag.Parse()
 }
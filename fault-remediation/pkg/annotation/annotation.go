@@ -14,7 +14,7 @@
 
 // Package annotation provides functionality for managing node remediation state
 // through Kubernetes node annotations. It enables tracking of ongoing remediation
-// actions across equivalence groups.
+// actions across equivalence groups and keeps a bounded history of past remediations.
 package annotation
 
 import (
@@ -22,9 +22,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
@@ -231,3 +234,187 @@ func (m *NodeAnnotationManager) RemoveGroupsFromState(ctx context.Context, nodeN
 
 	return nil
 }
+
+// GetRemediationHistory retrieves the remediation history from node annotation
+func (m *NodeAnnotationManager) GetRemediationHistory(
+	ctx context.Context,
+	nodeName string,
+) (*RemediationHistoryAnnotation, error) {
+	node := &corev1.Node{}
+
+	if err := m.client.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+
+	return parseRemediationHistory(ctx, node), nil
+}
+
+// RecordRemediation appends a remediation attempt to the node's history annotation
+// and updates the aggregate counters. Only the latest MaxHistoryEntries attempts are kept.
+func (m *NodeAnnotationManager) RecordRemediation(ctx context.Context, nodeName string,
+	actionName string, crName string, gpuUUID string, outcome string) error {
+	err := retry.RetryOnConflict(conflictBackoff, func() error {
+		node := &corev1.Node{}
+
+		if err := m.client.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+			return err
+		}
+
+		history := parseRemediationHistory(ctx, node)
+		now := time.Now().UTC()
+
+		if history.Count == 0 {
+			history.FirstRemediatedAt = now
+		}
+
+		history.Count++
+		history.LastRemediatedAt = now
+		history.LastActionName = actionName
+		history.LastOutcome = outcome
+
+		switch outcome {
+		case RemediationOutcomeSucceeded:
+			history.SucceededCount++
+
+			if gpuUUID != "" {
+				if history.GPULastRemediatedAt == nil {
+					history.GPULastRemediatedAt = map[string]time.Time{}
+				}
+
+				history.GPULastRemediatedAt[gpuUUID] = now
+			}
+		case RemediationOutcomeFailed:
+			history.FailedCount++
+		}
+
+		history.Entries = append(history.Entries, RemediationHistoryEntry{
+			Timestamp:     now,
+			ActionName:    actionName,
+			MaintenanceCR: crName,
+			Outcome:       outcome,
+		})
+		if len(history.Entries) > MaxHistoryEntries {
+			history.Entries = history.Entries[len(history.Entries)-MaxHistoryEntries:]
+		}
+
+		historyJSON, err := json.Marshal(history)
+		if err != nil {
+			return err
+		}
+
+		updatedNode := node.DeepCopy()
+		if updatedNode.Annotations == nil {
+			updatedNode.Annotations = map[string]string{}
+		}
+
+		updatedNode.Annotations[HistoryAnnotationKey] = string(historyJSON)
+
+		if err = m.client.Update(ctx, updatedNode); err != nil {
+			return err
+		}
+
+		slog.InfoContext(ctx, "Recorded remediation in node history",
+			"node", nodeName,
+			"action", actionName,
+			"outcome", outcome,
+			"count", history.Count)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record remediation history for node %s: %w", nodeName, err)
+	}
+
+	return nil
+}
+
+// SetRemediationDeferredCondition sets the RemediationDeferred node condition to True, naming
+// taintKeys, or to False once taintKeys is empty. The node status is only written when the
+// condition changes, and a node that never had the condition is left alone when clearing it.
+func (m *NodeAnnotationManager) SetRemediationDeferredCondition(ctx context.Context, nodeName string,
+	taintKeys []string) error {
+	status := corev1.ConditionFalse
+	reason := "MaintenanceTaintsRemoved"
+	message := "No maintenance taints defer remediation"
+
+	if len(taintKeys) > 0 {
+		status = corev1.ConditionTrue
+		reason = "MaintenanceTaintPresent"
+		message = fmt.Sprintf("Remediation deferred until taints are removed: %s", strings.Join(taintKeys, ", "))
+	}
+
+	err := retry.RetryOnConflict(conflictBackoff, func() error {
+		node := &corev1.Node{}
+
+		if err := m.client.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+			return err
+		}
+
+		idx := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool {
+			return c.Type == RemediationDeferredConditionType
+		})
+
+		if idx < 0 && status == corev1.ConditionFalse {
+			return nil
+		}
+
+		if idx >= 0 && node.Status.Conditions[idx].Status == status &&
+			node.Status.Conditions[idx].Message == message {
+			return nil
+		}
+
+		now := metav1.Now()
+		condition := corev1.NodeCondition{
+			Type:               RemediationDeferredConditionType,
+			Status:             status,
+			LastHeartbeatTime:  now,
+			LastTransitionTime: now,
+			Reason:             reason,
+			Message:            message,
+		}
+
+		updatedNode := node.DeepCopy()
+		if idx < 0 {
+			updatedNode.Status.Conditions = append(updatedNode.Status.Conditions, condition)
+		} else {
+			if updatedNode.Status.Conditions[idx].Status == status {
+				condition.LastTransitionTime = updatedNode.Status.Conditions[idx].LastTransitionTime
+			}
+
+			updatedNode.Status.Conditions[idx] = condition
+		}
+
+		if err := m.client.Status().Update(ctx, updatedNode); err != nil {
+			return err
+		}
+
+		slog.InfoContext(ctx, "Updated remediation deferred condition",
+			"node", nodeName,
+			"status", status,
+			"taints", taintKeys)
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update remediation deferred condition for node %s: %w", nodeName, err)
+	}
+
+	return nil
+}
+
+// parseRemediationHistory reads the history annotation from a node, returning an
+// empty history when the annotation is missing or malformed.
+func parseRemediationHistory(ctx context.Context, node *corev1.Node) *RemediationHistoryAnnotation {
+	annotationValue, exists := node.Annotations[HistoryAnnotationKey]
+	if !exists {
+		return &RemediationHistoryAnnotation{}
+	}
+
+	var history RemediationHistoryAnnotation
+	if err := json.Unmarshal([]byte(annotationValue), &history); err != nil {
+		slog.ErrorContext(ctx, "Failed to unmarshal history annotation", "node", node.Name, "error", err)
+		return &RemediationHistoryAnnotation{}
+	}
+
+	return &history
+}
@@ -24,6 +24,23 @@ import (
 const (
 	// AnnotationKey is the key for the node annotation that tracks remediation state
 	AnnotationKey = "latestFaultRemediationState"
+
+	// HistoryAnnotationKey is the key for the node annotation that records past remediations.
+	// Unlike AnnotationKey it is never cleared, so it survives cancellation and unquarantine.
+	HistoryAnnotationKey = "faultRemediationHistory"
+
+	// MaxHistoryEntries bounds the number of remediation attempts kept in the history annotation.
+	MaxHistoryEntries = 10
+
+	// RemediationOutcomeSucceeded is the outcome recorded when the maintenance CR was created.
+	RemediationOutcomeSucceeded = "succeeded"
+
+	// RemediationOutcomeFailed is the outcome recorded when the maintenance CR could not be created.
+	RemediationOutcomeFailed = "failed"
+
+	// RemediationDeferredConditionType is the node condition set while remediation waits for
+	// externally applied maintenance taints to be removed from the node.
+	RemediationDeferredConditionType = "RemediationDeferred"
 )
 
 // NodeAnnotationManagerInterface defines the interface for managing node annotations
@@ -32,6 +49,10 @@ type NodeAnnotationManagerInterface interface {
 	UpdateRemediationState(ctx context.Context, nodeName string, group string, crName string, actionName string) error
 	ClearRemediationState(ctx context.Context, nodeName string) error
 	RemoveGroupsFromState(ctx context.Context, nodeName string, groups []string) error
+	GetRemediationHistory(ctx context.Context, nodeName string) (*RemediationHistoryAnnotation, error)
+	RecordRemediation(ctx context.Context, nodeName string, actionName string, crName string,
+		gpuUUID string, outcome string) error
+	SetRemediationDeferredCondition(ctx context.Context, nodeName string, taintKeys []string) error
 }
 
 // RemediationStateAnnotation represents the structure of the node annotation
@@ -48,3 +69,29 @@ type EquivalenceGroupState struct {
 	// Required to look up the corresponding MaintenanceResource from the TomlConfig
 	ActionName string `json:"actionName"`
 }
+
+// RemediationHistoryAnnotation represents the structure of the remediation history node annotation
+type RemediationHistoryAnnotation struct {
+	// Count is the total number of remediation attempts on the node, including
+	// attempts that have been dropped from Entries.
+	Count             int                       `json:"count"`
+	FirstRemediatedAt time.Time                 `json:"firstRemediatedAt"`
+	LastRemediatedAt  time.Time                 `json:"lastRemediatedAt"`
+	LastActionName    string                    `json:"lastActionName"`
+	LastOutcome       string                    `json:"lastOutcome"`
+	SucceededCount    int                       `json:"succeededCount"`
+	FailedCount       int                       `json:"failedCount"`
+	Entries           []RemediationHistoryEntry `json:"entries,omitempty"`
+
+	// GPULastRemediatedAt maps a GPU UUID to the time of its last successful GPU-scoped
+	// remediation. It is not bounded by MaxHistoryEntries so cooldowns outlive the entry list.
+	GPULastRemediatedAt map[string]time.Time `json:"gpuLastRemediatedAt,omitempty"`
+}
+
+// RemediationHistoryEntry represents a single remediation attempt, newest last
+type RemediationHistoryEntry struct {
+	Timestamp     time.Time `json:"timestamp"`
+	ActionName    string    `json:"actionName"`
+	MaintenanceCR string    `json:"maintenanceCR,omitempty"`
+	Outcome       string    `json:"outcome"`
+}
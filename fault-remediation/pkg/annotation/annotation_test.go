@@ -327,3 +327,141 @@ func TestConcurrentUpdateAndRemoveGroupsFromState(t *testing.T) {
 		_ = annotationManager.RemoveGroupsFromState(context.TODO(), nodeName, []string{"existing-group-1", "existing-group-2", "new-group"})
 	}
 }
+
+func TestRecordRemediationAccumulatesHistory(t *testing.T) {
+	ctx := context.Background()
+	nodeName := "node"
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nodeName,
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(node).Build()
+	annotationManager := NodeAnnotationManager{
+		client: client,
+	}
+
+	history, err := annotationManager.GetRemediationHistory(ctx, nodeName)
+	require.NoError(t, err)
+	assert.Zero(t, history.Count)
+
+	require.NoError(t, annotationManager.RecordRemediation(ctx, nodeName, "RESTART_BM", "cr-1", "", RemediationOutcomeSucceeded))
+	require.NoError(t, annotationManager.UpdateRemediationState(ctx, nodeName, "restart", "cr-1", "RESTART_BM"))
+	require.NoError(t, annotationManager.ClearRemediationState(ctx, nodeName))
+	require.NoError(t, annotationManager.RecordRemediation(ctx, nodeName, "COMPONENT_RESET", "", "", RemediationOutcomeFailed))
+
+	history, err = annotationManager.GetRemediationHistory(ctx, nodeName)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, history.Count, "history must survive clearing the remediation state")
+	assert.Equal(t, 1, history.SucceededCount)
+	assert.Equal(t, 1, history.FailedCount)
+	assert.Equal(t, "COMPONENT_RESET", history.LastActionName)
+	assert.Equal(t, RemediationOutcomeFailed, history.LastOutcome)
+	assert.False(t, history.FirstRemediatedAt.After(history.LastRemediatedAt))
+	require.Len(t, history.Entries, 2)
+	assert.Equal(t, "cr-1", history.Entries[0].MaintenanceCR)
+	assert.Equal(t, RemediationOutcomeSucceeded, history.Entries[0].Outcome)
+	assert.Equal(t, "COMPONENT_RESET", history.Entries[1].ActionName)
+}
+
+func TestRecordRemediationBoundsEntries(t *testing.T) {
+	ctx := context.Background()
+	nodeName := "node"
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nodeName,
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(node).Build()
+	annotationManager := NodeAnnotationManager{
+		client: client,
+	}
+
+	total := MaxHistoryEntries + 3
+	for i := range total {
+		require.NoError(t, annotationManager.RecordRemediation(ctx, nodeName, "RESTART_BM",
+			fmt.Sprintf("cr-%d", i), "", RemediationOutcomeSucceeded))
+	}
+
+	history, err := annotationManager.GetRemediationHistory(ctx, nodeName)
+	require.NoError(t, err)
+
+	assert.Equal(t, total, history.Count)
+	assert.Equal(t, total, history.SucceededCount)
+	require.Len(t, history.Entries, MaxHistoryEntries)
+	assert.Equal(t, "cr-3", history.Entries[0].MaintenanceCR, "oldest entries should be dropped first")
+	assert.Equal(t, fmt.Sprintf("cr-%d", total-1), history.Entries[MaxHistoryEntries-1].MaintenanceCR)
+}
+
+func TestRecordRemediationTracksGPUTimestamps(t *testing.T) {
+	ctx := context.Background()
+	nodeName := "node"
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nodeName,
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(node).Build()
+	annotationManager := NodeAnnotationManager{
+		client: client,
+	}
+
+	require.NoError(t, annotationManager.RecordRemediation(ctx, nodeName, "COMPONENT_RESET", "cr-1",
+		"GPU-123", RemediationOutcomeSucceeded))
+	require.NoError(t, annotationManager.RecordRemediation(ctx, nodeName, "COMPONENT_RESET", "",
+		"GPU-456", RemediationOutcomeFailed))
+	require.NoError(t, annotationManager.RecordRemediation(ctx, nodeName, "RESTART_BM", "cr-2",
+		"", RemediationOutcomeSucceeded))
+
+	history, err := annotationManager.GetRemediationHistory(ctx, nodeName)
+	require.NoError(t, err)
+
+	require.Len(t, history.GPULastRemediatedAt, 1, "only successful GPU-scoped remediations are tracked")
+	assert.False(t, history.GPULastRemediatedAt["GPU-123"].IsZero())
+	assert.NotContains(t, history.GPULastRemediatedAt, "GPU-456")
+}
+
+func TestSetRemediationDeferredCondition(t *testing.T) {
+	ctx := context.Background()
+	nodeName := "node"
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: nodeName,
+		},
+	}
+	client := fake.NewClientBuilder().WithObjects(node).WithStatusSubresource(node).Build()
+	annotationManager := NodeAnnotationManager{
+		client: client,
+	}
+
+	getCondition := func() *corev1.NodeCondition {
+		updated := &corev1.Node{}
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Name: nodeName}, updated))
+
+		for i := range updated.Status.Conditions {
+			if updated.Status.Conditions[i].Type == RemediationDeferredConditionType {
+				return &updated.Status.Conditions[i]
+			}
+		}
+
+		return nil
+	}
+
+	require.NoError(t, annotationManager.SetRemediationDeferredCondition(ctx, nodeName, nil))
+	assert.Nil(t, getCondition(), "clearing a node without the condition should not add it")
+
+	require.NoError(t, annotationManager.SetRemediationDeferredCondition(ctx, nodeName,
+		[]string{"example.com/maintenance"}))
+
+	condition := getCondition()
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Contains(t, condition.Message, "example.com/maintenance")
+
+	require.NoError(t, annotationManager.SetRemediationDeferredCondition(ctx, nodeName, nil))
+
+	condition = getCondition()
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionFalse, condition.Status)
+}
@@ -22,6 +22,8 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/nvidia/nvsentinel/data-models/pkg/model"
 	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
@@ -72,6 +74,55 @@ type UpdateRetry struct {
 	RetryDelaySeconds int `toml:"retryDelaySeconds"`
 }
 
+// EventPublisherTypeNATS publishes phase transitions to a NATS subject.
+const EventPublisherTypeNATS = "nats"
+
+const (
+	defaultEventPublisherQueueSize      = 1000
+	defaultEventPublisherTimeoutSeconds = 5
+)
+
+// EventPublisher holds configuration for publishing remediation phase transitions to a message sink.
+// Credentials are not part of the configuration; they are read from the environment, which the
+// chart populates from a Secret.
+type EventPublisher struct {
+	Enabled        bool              `toml:"enabled"`
+	Type           string            `toml:"type"`
+	URL            string            `toml:"url"`
+	Subject        string            `toml:"subject"`
+	QueueSize      int               `toml:"queueSize"`
+	TimeoutSeconds int               `toml:"timeoutSeconds"`
+	TLS            EventPublisherTLS `toml:"tls"`
+}
+
+// EventPublisherTLS holds the TLS settings for the connection to the sink
+type EventPublisherTLS struct {
+	Enabled bool `toml:"enabled"`
+	// CAFile verifies the server certificate. Empty uses the system roots.
+	CAFile string `toml:"caFile"`
+	// CertFile and KeyFile present a client certificate for mutual TLS. Both or neither are set.
+	CertFile string `toml:"certFile"`
+	KeyFile  string `toml:"keyFile"`
+}
+
+// GetQueueSize returns the number of transitions buffered before new ones are dropped.
+func (p *EventPublisher) GetQueueSize() int {
+	if p.QueueSize <= 0 {
+		return defaultEventPublisherQueueSize
+	}
+
+	return p.QueueSize
+}
+
+// GetTimeout returns the connect and write timeout for the sink.
+func (p *EventPublisher) GetTimeout() time.Duration {
+	if p.TimeoutSeconds <= 0 {
+		return defaultEventPublisherTimeoutSeconds * time.Second
+	}
+
+	return time.Duration(p.TimeoutSeconds) * time.Second
+}
+
 // TomlConfig holds the complete TOML configuration for fault remediation
 type TomlConfig struct {
 	// Template mount configuration
@@ -85,6 +136,21 @@ type TomlConfig struct {
 
 	// Common configuration
 	UpdateRetry UpdateRetry `toml:"updateRetry"`
+
+	// MaxReconcileRetries is the number of consecutive failed reconciles after which an event is
+	// dead-lettered. Zero uses the default.
+	MaxReconcileRetries int `toml:"maxReconcileRetries"`
+
+	// GPURemediationCooldownSeconds suppresses GPU-scoped remediations of a GPU that was
+	// successfully remediated within this many seconds. Zero disables the cooldown.
+	GPURemediationCooldownSeconds int `toml:"gpuRemediationCooldownSeconds"`
+
+	// DeferRemediationTaintKeys defers remediation while the node carries a taint with any of
+	// these keys, so NVSentinel does not act on a node another controller is already maintaining.
+	DeferRemediationTaintKeys []string `toml:"deferRemediationTaintKeys"`
+
+	// Optional sink for remediation phase transitions
+	EventPublisher EventPublisher `toml:"eventPublisher"`
 }
 
 // Validate checks the configuration for consistency and completeness.
@@ -105,6 +171,10 @@ func (c *TomlConfig) Validate() error {
 		return err
 	}
 
+	if err := c.validateEventPublisher(); err != nil {
+		return err
+	}
+
 	actionNames := sortedActionNames(c.RemediationActions)
 
 	for _, actionName := range actionNames {
@@ -122,6 +192,35 @@ func (c *TomlConfig) Validate() error {
 	return nil
 }
 
+func (c *TomlConfig) validateEventPublisher() error {
+	if !c.EventPublisher.Enabled {
+		return nil
+	}
+
+	if c.EventPublisher.Type != EventPublisherTypeNATS {
+		return fmt.Errorf("eventPublisher type must be %q, got %q", EventPublisherTypeNATS, c.EventPublisher.Type)
+	}
+
+	if c.EventPublisher.URL == "" {
+		return fmt.Errorf("eventPublisher url must be non-empty")
+	}
+
+	if strings.Contains(c.EventPublisher.URL, "@") {
+		return fmt.Errorf("eventPublisher url must not contain credentials; provide them through the credentials secret")
+	}
+
+	if c.EventPublisher.Subject == "" {
+		return fmt.Errorf("eventPublisher subject must be non-empty")
+	}
+
+	tls := c.EventPublisher.TLS
+	if (tls.CertFile == "") != (tls.KeyFile == "") {
+		return fmt.Errorf("eventPublisher tls certFile and keyFile must be set together")
+	}
+
+	return nil
+}
+
 func sortedActionNames(actions map[string]MaintenanceResource) []string {
 	names := make([]string, 0, len(actions))
 	for name := range actions {
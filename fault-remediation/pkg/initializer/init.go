@@ -27,6 +27,7 @@ import (
 	"github.com/nvidia/nvsentinel/commons/pkg/configmanager"
 	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/config"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/publisher"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/reconciler"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/remediation"
 	"github.com/nvidia/nvsentinel/store-client/pkg/client"
@@ -46,6 +47,7 @@ type InitializationParams struct {
 
 type Components struct {
 	FaultRemediationReconciler *reconciler.FaultRemediationReconciler
+	HealthEventStore           datastore.HealthEventStore
 }
 
 func InitializeAll(
@@ -90,15 +92,24 @@ func InitializeAll(
 		TokenCollection: tokenConfig.TokenCollection,
 	}
 
+	eventPublisher, err := publisher.New(tomlConfig.EventPublisher)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing event publisher: %w", err)
+	}
+
 	reconcilerCfg := reconciler.ReconcilerConfig{
-		DataStoreConfig:    *datastoreConfig,
-		TokenConfig:        clientTokenConfig,
-		Pipeline:           pipeline,
-		RemediationClient:  remediationClient,
-		StateManager:       stateManager,
-		EnableLogCollector: params.EnableLogCollector,
-		UpdateMaxRetries:   tomlConfig.UpdateRetry.MaxRetries,
-		UpdateRetryDelay:   time.Duration(tomlConfig.UpdateRetry.RetryDelaySeconds) * time.Second,
+		DataStoreConfig:           *datastoreConfig,
+		TokenConfig:               clientTokenConfig,
+		Pipeline:                  pipeline,
+		RemediationClient:         remediationClient,
+		StateManager:              stateManager,
+		EnableLogCollector:        params.EnableLogCollector,
+		UpdateMaxRetries:          tomlConfig.UpdateRetry.MaxRetries,
+		UpdateRetryDelay:          time.Duration(tomlConfig.UpdateRetry.RetryDelaySeconds) * time.Second,
+		MaxReconcileRetries:       tomlConfig.MaxReconcileRetries,
+		Publisher:                 eventPublisher,
+		GPURemediationCooldown:    time.Duration(tomlConfig.GPURemediationCooldownSeconds) * time.Second,
+		DeferRemediationTaintKeys: tomlConfig.DeferRemediationTaintKeys,
 	}
 
 	slog.Info("Initialization completed successfully")
@@ -106,6 +117,7 @@ func InitializeAll(
 	return &Components{
 		FaultRemediationReconciler: reconciler.NewFaultRemediationReconciler(
 			ds, watcherInstance, healthEventStore, reconcilerCfg, params.DryRun),
+		HealthEventStore: healthEventStore,
 	}, nil
 }
 
@@ -57,6 +57,43 @@ var (
 		},
 		[]string{"action", "node_name"},
 	)
+	EventsDeadLettered = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_remediation_events_dead_lettered_total",
+			Help: "Total number of events moved to the failed phase after exhausting reconcile retries.",
+		},
+		[]string{"node_name"},
+	)
+	GPUCooldownSkips = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_remediation_gpu_cooldown_skips_total",
+			Help: "Total number of GPU-scoped remediations deferred because the GPU was remediated within the cooldown window.",
+		},
+		[]string{"node_name"},
+	)
+	RemediationDeferredByTaint = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_remediation_deferred_by_taint_total",
+			Help: "Total number of remediation attempts deferred because the node carries a configured maintenance taint.",
+		},
+		[]string{"node_name"},
+	)
+
+	// Phase Transition Publishing Metrics
+	TransitionsPublished = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_remediation_transitions_published_total",
+			Help: "Total number of phase transitions published to the event sink.",
+		},
+		[]string{"phase"},
+	)
+	TransitionPublishErrors = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "fault_remediation_transition_publish_errors_total",
+			Help: "Total number of phase transitions that could not be published.",
+		},
+		[]string{"error_type"},
+	)
 
 	// Performance Metrics
 	EventHandlingDuration = promauto.With(crmetrics.Registry).NewHistogram(
@@ -0,0 +1,174 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/config"
+)
+
+const (
+	// NATSUserEnv and NATSPasswordEnv hold the NATS credentials. The chart sets them from a Secret
+	// so that credentials never appear in the ConfigMap.
+	NATSUserEnv     = "NATS_USER"
+	NATSPasswordEnv = "NATS_PASSWORD"
+	// NATSCredsFileEnv points at a mounted NATS credentials (JWT + NKey seed) file.
+	NATSCredsFileEnv = "NATS_CREDS_FILE"
+)
+
+// NATSPublisher publishes transitions as JSON messages to a NATS subject. Messages are
+// fire-and-forget; the client reconnects in the background and publishes fail while it is
+// disconnected, so the caller decides whether to drop or retry.
+type NATSPublisher struct {
+	conn    *nats.Conn
+	subject string
+}
+
+// NewNATSPublisher creates a publisher for the nats:// or tls:// server URLs in cfg. The initial
+// connection is retried in the background, so an unavailable server does not prevent startup.
+func NewNATSPublisher(cfg config.EventPublisher) (*NATSPublisher, error) {
+	if err := validateNATSURL(cfg.URL); err != nil {
+		return nil, err
+	}
+
+	if cfg.Subject == "" || strings.ContainsAny(cfg.Subject, " \t\r\n") {
+		return nil, fmt.Errorf("invalid NATS subject %q", cfg.Subject)
+	}
+
+	opts := []nats.Option{
+		nats.Name("fault-remediation"),
+		nats.Timeout(cfg.GetTimeout()),
+		nats.RetryOnFailedConnect(true),
+		nats.MaxReconnects(-1),
+		// Transitions are already queued by AsyncPublisher; fail fast instead of buffering twice.
+		nats.ReconnectBufSize(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				slog.Warn("NATS connection lost", "error", err)
+			}
+		}),
+		nats.ConnectHandler(func(nc *nats.Conn) {
+			slog.Info("Connected to NATS server", "url", nc.ConnectedUrlRedacted())
+		}),
+		nats.ReconnectHandler(func(nc *nats.Conn) {
+			slog.Info("Reconnected to NATS server", "url", nc.ConnectedUrlRedacted())
+		}),
+	}
+
+	opts = append(opts, natsCredentialOptions()...)
+
+	if cfg.TLS.Enabled {
+		opts = append(opts, natsTLSOptions(cfg.TLS)...)
+	}
+
+	conn, err := nats.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create NATS connection: %w", err)
+	}
+
+	return &NATSPublisher{conn: conn, subject: cfg.Subject}, nil
+}
+
+// Publish sends the transition to the configured subject.
+func (p *NATSPublisher) Publish(_ context.Context, transition Transition) error {
+	payload, err := json.Marshal(transition)
+	if err != nil {
+		return fmt.Errorf("failed to marshal transition: %w", err)
+	}
+
+	if !p.conn.IsConnected() {
+		return fmt.Errorf("failed to publish to NATS subject %s: %w", p.subject, nats.ErrConnectionClosed)
+	}
+
+	if err := p.conn.Publish(p.subject, payload); err != nil {
+		return fmt.Errorf("failed to publish to NATS subject %s: %w", p.subject, err)
+	}
+
+	return nil
+}
+
+// Close flushes pending messages and closes the connection to the NATS server.
+func (p *NATSPublisher) Close(ctx context.Context) error {
+	defer p.conn.Close()
+
+	if !p.conn.IsConnected() {
+		return nil
+	}
+
+	if err := p.conn.FlushWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to flush NATS connection: %w", err)
+	}
+
+	return nil
+}
+
+func validateNATSURL(rawURLs string) error {
+	if rawURLs == "" {
+		return fmt.Errorf("NATS URL must be non-empty")
+	}
+
+	for _, rawURL := range strings.Split(rawURLs, ",") {
+		u, err := url.Parse(strings.TrimSpace(rawURL))
+		if err != nil {
+			return fmt.Errorf("invalid NATS URL %q: %w", rawURL, err)
+		}
+
+		if (u.Scheme != "nats" && u.Scheme != "tls") || u.Hostname() == "" {
+			return fmt.Errorf("invalid NATS URL %q: expected nats://host[:port] or tls://host[:port]", rawURL)
+		}
+
+		if u.User != nil {
+			return fmt.Errorf("invalid NATS URL %q: credentials must be provided through %s/%s or %s",
+				u.Redacted(), NATSUserEnv, NATSPasswordEnv, NATSCredsFileEnv)
+		}
+	}
+
+	return nil
+}
+
+func natsCredentialOptions() []nats.Option {
+	if credsFile := os.Getenv(NATSCredsFileEnv); credsFile != "" {
+		return []nats.Option{nats.UserCredentials(credsFile)}
+	}
+
+	if user := os.Getenv(NATSUserEnv); user != "" {
+		return []nats.Option{nats.UserInfo(user, os.Getenv(NATSPasswordEnv))}
+	}
+
+	return nil
+}
+
+func natsTLSOptions(cfg config.EventPublisherTLS) []nats.Option {
+	opts := []nats.Option{nats.Secure()}
+
+	if cfg.CAFile != "" {
+		opts = append(opts, nats.RootCAs(cfg.CAFile))
+	}
+
+	if cfg.CertFile != "" {
+		opts = append(opts, nats.ClientCert(cfg.CertFile, cfg.KeyFile))
+	}
+
+	return opts
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package publisher emits remediation phase transitions to an external message sink so that
+// downstream systems can follow the pipeline without watching CRDs or the datastore.
+package publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/config"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/metrics"
+)
+
+// ErrQueueFull is returned when a transition is dropped because the publish queue is full.
+var ErrQueueFull = errors.New("publish queue is full")
+
+// ErrClosed is returned when publishing to a closed publisher.
+var ErrClosed = errors.New("publisher is closed")
+
+// Transition is the message published when a health event moves to a new phase.
+type Transition struct {
+	EventID           string    `json:"eventId"`
+	NodeName          string    `json:"nodeName"`
+	Phase             string    `json:"phase"`
+	Agent             string    `json:"agent,omitempty"`
+	CheckName         string    `json:"checkName,omitempty"`
+	RecommendedAction string    `json:"recommendedAction,omitempty"`
+	Reason            string    `json:"reason,omitempty"`
+	Timestamp         time.Time `json:"timestamp"`
+}
+
+// Publisher sends transitions to a message sink.
+type Publisher interface {
+	Publish(ctx context.Context, transition Transition) error
+	Close(ctx context.Context) error
+}
+
+// New creates the publisher described by cfg, or returns nil if publishing is disabled. The
+// returned publisher never blocks the caller: transitions are queued and sent in the background.
+func New(cfg config.EventPublisher) (Publisher, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	var sink Publisher
+
+	switch cfg.Type {
+	case config.EventPublisherTypeNATS:
+		nats, err := NewNATSPublisher(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		sink = nats
+	default:
+		return nil, fmt.Errorf("unsupported event publisher type %q", cfg.Type)
+	}
+
+	slog.Info("Event publisher enabled", "type", cfg.Type, "subject", cfg.Subject)
+
+	return NewAsyncPublisher(sink, cfg.GetQueueSize()), nil
+}
+
+// AsyncPublisher queues transitions and publishes them from a background goroutine so that a
+// slow or unavailable sink cannot stall reconciliation. Transitions are dropped when the queue
+// is full.
+type AsyncPublisher struct {
+	next  Publisher
+	queue chan Transition
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewAsyncPublisher starts publishing queued transitions to next.
+func NewAsyncPublisher(next Publisher, queueSize int) *AsyncPublisher {
+	p := &AsyncPublisher{
+		next:  next,
+		queue: make(chan Transition, queueSize),
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+
+	go p.run()
+
+	return p
+}
+
+// Publish queues the transition without waiting for it to be sent.
+func (p *AsyncPublisher) Publish(ctx context.Context, transition Transition) error {
+	select {
+	case <-p.stop:
+		return ErrClosed
+	default:
+	}
+
+	select {
+	case p.queue <- transition:
+		return nil
+	default:
+		metrics.TransitionPublishErrors.WithLabelValues("queue_full").Inc()
+		return ErrQueueFull
+	}
+}
+
+// Close stops accepting transitions, sends the ones already queued until ctx is done and closes
+// the underlying publisher.
+func (p *AsyncPublisher) Close(ctx context.Context) error {
+	p.closeOnce.Do(func() { close(p.stop) })
+
+	select {
+	case <-p.done:
+	case <-ctx.Done():
+		slog.Warn("Timed out flushing queued phase transitions", "remaining", len(p.queue))
+	}
+
+	return p.next.Close(ctx)
+}
+
+func (p *AsyncPublisher) run() {
+	defer close(p.done)
+
+	for {
+		select {
+		case transition := <-p.queue:
+			p.send(transition)
+		case <-p.stop:
+			for {
+				select {
+				case transition := <-p.queue:
+					p.send(transition)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *AsyncPublisher) send(transition Transition) {
+	if err := p.next.Publish(context.Background(), transition); err != nil {
+		metrics.TransitionPublishErrors.WithLabelValues("publish_error").Inc()
+		slog.Warn("Failed to publish phase transition",
+			"event", transition.EventID,
+			"node", transition.NodeName,
+			"phase", transition.Phase,
+			"error", err)
+
+		return
+	}
+
+	metrics.TransitionsPublished.WithLabelValues(transition.Phase).Inc()
+}
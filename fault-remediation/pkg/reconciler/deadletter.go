@@ -0,0 +1,147 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/common"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/events"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/metrics"
+	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
+	"github.com/nvidia/nvsentinel/store-client/pkg/query"
+)
+
+// DefaultMaxReconcileRetries is the number of consecutive failed reconciles of an event after
+// which it is dead-lettered when MaxReconcileRetries is not set.
+const DefaultMaxReconcileRetries = 20
+
+// lastErrorField is where the error that caused an event to be dead-lettered is recorded.
+const lastErrorField = "healtheventstatus.lasterror"
+
+func (r *FaultRemediationReconciler) maxReconcileRetries() int {
+	if r.Config.MaxReconcileRetries <= 0 {
+		return DefaultMaxReconcileRetries
+	}
+
+	return r.Config.MaxReconcileRetries
+}
+
+// isCancellationEvent reports whether the event cancels remediation because its node was
+// unquarantined or its quarantine was cancelled.
+func isCancellationEvent(healthEventWithStatus *events.HealthEventDoc) bool {
+	nodeQuarantined := healthEventWithStatus.HealthEventStatus.NodeQuarantined
+
+	return nodeQuarantined == string(model.UnQuarantined) || nodeQuarantined == string(model.Cancelled)
+}
+
+// deadLetterIfExhausted counts consecutive reconcile failures of an event. Once the count reaches
+// the retry threshold the event is moved to the failed terminal phase and the error is swallowed
+// so controller-runtime stops requeueing it. If the event cannot be dead-lettered the original
+// error is returned and it is retried as usual.
+func (r *FaultRemediationReconciler) deadLetterIfExhausted(
+	ctx context.Context,
+	healthEventWithStatus *events.HealthEventDoc,
+	eventWithToken datastore.EventWithToken,
+	result ctrl.Result,
+	reconcileErr error,
+) (ctrl.Result, error) {
+	eventID := healthEventWithStatus.ID
+
+	if reconcileErr == nil {
+		r.reconcileFailures.Delete(eventID)
+		return result, nil
+	}
+
+	// A cancellation event's node has already been uncordoned, so marking it remediation-failed
+	// would be wrong; cancellation events keep retrying instead.
+	if isCancellationEvent(healthEventWithStatus) {
+		return result, reconcileErr
+	}
+
+	attempts := 1
+	if val, ok := r.reconcileFailures.Load(eventID); ok {
+		attempts = val.(int) + 1
+	}
+
+	if attempts < r.maxReconcileRetries() {
+		r.reconcileFailures.Store(eventID, attempts)
+		return result, reconcileErr
+	}
+
+	if err := r.deadLetterEvent(ctx, healthEventWithStatus, eventWithToken, attempts, reconcileErr); err != nil {
+		slog.ErrorContext(ctx, "Failed to dead-letter event, will retry",
+			"id", eventID,
+			"error", err)
+		r.reconcileFailures.Store(eventID, attempts)
+
+		return result, reconcileErr
+	}
+
+	r.reconcileFailures.Delete(eventID)
+
+	return ctrl.Result{}, nil
+}
+
+// deadLetterEvent marks the event as not remediated, records the last error on the document,
+// sets the node state label to remediation-failed and advances the resume token.
+func (r *FaultRemediationReconciler) deadLetterEvent(
+	ctx context.Context,
+	healthEventWithStatus *events.HealthEventDoc,
+	eventWithToken datastore.EventWithToken,
+	attempts int,
+	lastErr error,
+) error {
+	nodeName := healthEventWithStatus.HealthEvent.NodeName
+
+	if err := r.updateNodeRemediatedStatus(ctx, r.healthEventStore, eventWithToken, false); err != nil {
+		return err
+	}
+
+	err := r.healthEventStore.UpdateHealthEventsByQuery(ctx,
+		query.New().Build(query.Eq("_id", healthEventWithStatus.ID)),
+		query.NewUpdate().Set(lastErrorField, lastErr.Error()))
+	if err != nil {
+		return fmt.Errorf("error recording last error for document with ID: %v, error: %w", healthEventWithStatus.ID, err)
+	}
+
+	if r.Config.StateManager != nil {
+		_, err = r.Config.StateManager.UpdateNVSentinelStateNodeLabel(ctx, nodeName,
+			statemanager.RemediationFailedLabelValue, false)
+		if err != nil {
+			slog.ErrorContext(ctx, "Error updating node label",
+				"label", statemanager.RemediationFailedLabelValue,
+				"error", err)
+			metrics.ProcessingErrors.WithLabelValues("label_update_error", nodeName).Inc()
+		}
+	}
+
+	metrics.EventsDeadLettered.WithLabelValues(nodeName).Inc()
+	r.publishTransition(ctx, healthEventWithStatus, common.PhaseRemediationFailed, lastErr.Error())
+
+	slog.ErrorContext(ctx, "Event failed processing repeatedly, moved to dead-letter",
+		"id", healthEventWithStatus.ID,
+		"node", nodeName,
+		"attempts", attempts,
+		"lastError", lastErr)
+
+	return safeMarkProcessed(ctx, r.Watcher, eventWithToken.ResumeToken, nodeName)
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -42,6 +43,7 @@ import (
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/common"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/events"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/metrics"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/publisher"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/remediation"
 	nvstoreclient "github.com/nvidia/nvsentinel/store-client/pkg/client"
 	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
@@ -50,17 +52,30 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const coldStartBatchSize = 1000
+const (
+	coldStartBatchSize = 1000
+
+	// taintDeferralRequeueInterval is how often a deferred remediation rechecks the node's taints.
+	taintDeferralRequeueInterval = 30 * time.Second
+)
 
 type ReconcilerConfig struct {
-	DataStoreConfig    datastore.DataStoreConfig
-	TokenConfig        nvstoreclient.TokenConfig
-	Pipeline           datastore.Pipeline
-	RemediationClient  remediation.FaultRemediationClientInterface
-	StateManager       statemanager.StateManager
-	EnableLogCollector bool
-	UpdateMaxRetries   int
-	UpdateRetryDelay   time.Duration
+	DataStoreConfig     datastore.DataStoreConfig
+	TokenConfig         nvstoreclient.TokenConfig
+	Pipeline            datastore.Pipeline
+	RemediationClient   remediation.FaultRemediationClientInterface
+	StateManager        statemanager.StateManager
+	EnableLogCollector  bool
+	UpdateMaxRetries    int
+	UpdateRetryDelay    time.Duration
+	MaxReconcileRetries int
+	Publisher           publisher.Publisher
+	// GPURemediationCooldown suppresses GPU-scoped remediations for a GPU that was successfully
+	// remediated within this window. Zero disables the cooldown.
+	GPURemediationCooldown time.Duration
+	// DeferRemediationTaintKeys defers remediation of a node while it carries a taint with any of
+	// these keys, e.g. one applied by an external maintenance controller.
+	DeferRemediationTaintKeys []string
 }
 
 // FaultRemediationReconciler reconciles health events from a datastore change stream
@@ -76,6 +91,7 @@ type FaultRemediationReconciler struct {
 	dryRun            bool
 	coldStartCh       chan event.TypedGenericEvent[*datastore.EventWithToken]
 	eventSessions     sync.Map
+	reconcileFailures sync.Map
 }
 
 type eventTraceSession struct {
@@ -141,6 +157,10 @@ func (r *FaultRemediationReconciler) Reconcile(
 		r.completeEventSession(healthEventWithStatus.ID, session, result, reconcileErr)
 	}()
 
+	defer func() {
+		result, reconcileErr = r.deadLetterIfExhausted(sessionCtx, &healthEventWithStatus, *event, result, reconcileErr)
+	}()
+
 	ctx, span := tracing.StartSpan(sessionCtx, "fault_remediation.reconcile")
 
 	defer span.End()
@@ -148,10 +168,16 @@ func (r *FaultRemediationReconciler) Reconcile(
 	// Add health event attributes to span (nil-safe: span and optional status fields)
 	tracing.AddHealthEventStatusAttributes(
 		span, healthEventWithStatus.HealthEventStatus, healthEventWithStatus.ID)
-	nodeQuarantined := healthEventWithStatus.HealthEventStatus.NodeQuarantined
+	if isCancellationEvent(&healthEventWithStatus) {
+		nodeQuarantined := healthEventWithStatus.HealthEventStatus.NodeQuarantined
 
-	if nodeQuarantined == string(model.UnQuarantined) || nodeQuarantined == string(model.Cancelled) {
-		return r.handleCancellationEvent(ctx, nodeName, model.Status(nodeQuarantined), r.Watcher, event.ResumeToken)
+		result, err := r.handleCancellationEvent(ctx, nodeName, model.Status(nodeQuarantined), r.Watcher,
+			event.ResumeToken)
+		if err == nil {
+			r.publishTransition(ctx, &healthEventWithStatus, common.PhaseCancelled, nodeQuarantined)
+		}
+
+		return result, err
 	}
 
 	return r.handleRemediationEvent(ctx, &healthEventWithStatus, *event, r.Watcher, r.healthEventStore)
@@ -263,6 +289,100 @@ func (r *FaultRemediationReconciler) shouldSkipEvent(ctx context.Context,
 	return true
 }
 
+// gpuCooldownRemaining returns how long the GPU targeted by a GPU-scoped remediation stays in
+// its cooldown window after its last successful remediation, or 0 when the remediation may
+// proceed. The caller requeues the event for the remaining time instead of dropping it, so that
+// the node is remediated once the window ends. Errors reading the history fail open.
+func (r *FaultRemediationReconciler) gpuCooldownRemaining(ctx context.Context, nodeName string,
+	groupConfig *common.EquivalenceGroupConfig) time.Duration {
+	if groupConfig == nil {
+		return 0
+	}
+
+	gpuUUID := groupConfig.ImpactedEntityScopeValue
+	if r.Config.GPURemediationCooldown <= 0 || gpuUUID == "" || r.annotationManager == nil {
+		return 0
+	}
+
+	history, err := r.annotationManager.GetRemediationHistory(ctx, nodeName)
+	if err != nil {
+		slog.WarnContext(ctx, "Failed to read remediation history for GPU cooldown check",
+			"node", nodeName, "gpu", gpuUUID, "error", err)
+
+		return 0
+	}
+
+	lastRemediatedAt, ok := history.GPULastRemediatedAt[gpuUUID]
+	if !ok {
+		return 0
+	}
+
+	remaining := r.Config.GPURemediationCooldown - time.Since(lastRemediatedAt)
+	if remaining <= 0 {
+		return 0
+	}
+
+	slog.InfoContext(ctx, "Deferring remediation for node: GPU is within remediation cooldown",
+		"node", nodeName,
+		"gpu", gpuUUID,
+		"lastRemediatedAt", lastRemediatedAt,
+		"cooldown", r.Config.GPURemediationCooldown,
+		"requeueAfter", remaining)
+	metrics.GPUCooldownSkips.WithLabelValues(nodeName).Inc()
+
+	tracing.SpanFromContext(ctx).SetAttributes(
+		attribute.String("fault_remediation.defer_reason", "gpu_cooldown"),
+	)
+
+	return remaining
+}
+
+// deferForNodeTaints reports whether remediation of the node should wait because it carries one
+// of the configured DeferRemediationTaintKeys. It keeps the RemediationDeferred node condition in
+// sync with the result. Errors reading the node fail open.
+func (r *FaultRemediationReconciler) deferForNodeTaints(ctx context.Context, nodeName string) bool {
+	if len(r.Config.DeferRemediationTaintKeys) == 0 || r.annotationManager == nil {
+		return false
+	}
+
+	_, node, err := r.annotationManager.GetRemediationState(ctx, nodeName)
+	if err != nil || node == nil {
+		slog.WarnContext(ctx, "Failed to read node for remediation taint check",
+			"node", nodeName, "error", err)
+
+		return false
+	}
+
+	var taintKeys []string
+
+	for _, taint := range node.Spec.Taints {
+		if slices.Contains(r.Config.DeferRemediationTaintKeys, taint.Key) && !slices.Contains(taintKeys, taint.Key) {
+			taintKeys = append(taintKeys, taint.Key)
+		}
+	}
+
+	if err := r.annotationManager.SetRemediationDeferredCondition(ctx, nodeName, taintKeys); err != nil {
+		slog.WarnContext(ctx, "Failed to update remediation deferred condition",
+			"node", nodeName, "error", err)
+	}
+
+	if len(taintKeys) == 0 {
+		return false
+	}
+
+	slog.InfoContext(ctx, "Deferring remediation for node: maintenance taints present",
+		"node", nodeName,
+		"taints", taintKeys,
+		"requeueAfter", taintDeferralRequeueInterval)
+	metrics.RemediationDeferredByTaint.WithLabelValues(nodeName).Inc()
+
+	tracing.SpanFromContext(ctx).SetAttributes(
+		attribute.String("fault_remediation.defer_reason", "node_taint"),
+	)
+
+	return true
+}
+
 // runLogCollector runs log collector for non-NONE actions if enabled
 func (r *FaultRemediationReconciler) runLogCollector(
 	ctx context.Context,
@@ -342,6 +462,9 @@ func (r *FaultRemediationReconciler) performRemediation(ctx context.Context,
 		// don't throw error yet so we can update state
 	}
 
+	r.recordRemediationHistory(ctx, healthEventWithStatus.HealthEvent, groupConfig, crName,
+		createMaintenanceResourceError == nil)
+
 	_, err = r.Config.StateManager.UpdateNVSentinelStateNodeLabel(ctx,
 		healthEventWithStatus.HealthEvent.NodeName,
 		remediationLabelValue, false)
@@ -366,6 +489,30 @@ func (r *FaultRemediationReconciler) performRemediation(ctx context.Context,
 	return crName, nil
 }
 
+// recordRemediationHistory appends the remediation attempt to the node's history annotation.
+// Failures are logged but do not fail the remediation, since the history is informational.
+func (r *FaultRemediationReconciler) recordRemediationHistory(ctx context.Context,
+	healthEvent *protos.HealthEvent, groupConfig *common.EquivalenceGroupConfig, crName string, succeeded bool) {
+	if r.annotationManager == nil {
+		return
+	}
+
+	outcome := annotation.RemediationOutcomeSucceeded
+	if !succeeded {
+		outcome = annotation.RemediationOutcomeFailed
+	}
+
+	var gpuUUID string
+	if groupConfig != nil {
+		gpuUUID = groupConfig.ImpactedEntityScopeValue
+	}
+
+	if err := r.annotationManager.RecordRemediation(ctx, healthEvent.NodeName,
+		healthEvent.RecommendedAction.String(), crName, gpuUUID, outcome); err != nil {
+		slog.WarnContext(ctx, "Failed to record remediation history", "node", healthEvent.NodeName, "error", err)
+	}
+}
+
 // handleCancellationEvent handles node unquarantine and cancellation events by clearing annotations
 func (r *FaultRemediationReconciler) handleCancellationEvent(
 	ctx context.Context,
@@ -433,6 +580,22 @@ func (r *FaultRemediationReconciler) handleRemediationEvent(
 		return res, err
 	}
 
+	if remaining := r.gpuCooldownRemaining(ctx, nodeName, groupConfig); remaining > 0 {
+		span.SetAttributes(
+			attribute.String("fault_remediation.status", "deferred"),
+		)
+
+		return ctrl.Result{RequeueAfter: remaining}, nil
+	}
+
+	if r.deferForNodeTaints(ctx, nodeName) {
+		span.SetAttributes(
+			attribute.String("fault_remediation.status", "deferred"),
+		)
+
+		return ctrl.Result{RequeueAfter: taintDeferralRequeueInterval}, nil
+	}
+
 	shouldCreateCR, existingCR, err := r.checkExistingCRStatus(ctx, healthEvent, groupConfig)
 	if err != nil {
 		metrics.ProcessingErrors.WithLabelValues("cr_status_check_error", nodeName).Inc()
@@ -562,9 +725,13 @@ func (r *FaultRemediationReconciler) runLogCollectorAndRemediate(
 	}
 
 	if performRemediationErr != nil {
+		r.publishTransition(ctx, healthEventWithStatus, common.PhaseRemediationFailed, performRemediationErr.Error())
+
 		return ctrl.Result{}, performRemediationErr
 	}
 
+	r.publishTransition(ctx, healthEventWithStatus, common.PhaseRemediating, "")
+
 	return ctrl.Result{}, nil
 }
 
@@ -801,6 +968,13 @@ func (r *FaultRemediationReconciler) CloseAll(ctx context.Context) error {
 		errs = append(errs, err)
 	}
 
+	if r.Config.Publisher != nil {
+		if err := r.Config.Publisher.Close(ctx); err != nil {
+			slog.ErrorContext(ctx, "failed to close event publisher", "error", err)
+			errs = append(errs, err)
+		}
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -156,6 +156,7 @@ type MockHealthEventStore struct {
 	UpdateHealthEventStatusFn          func(ctx context.Context, id string, status datastore.HealthEventStatus) error
 	FindHealthEventsByQueryFn          func(ctx context.Context, builder datastore.QueryBuilder) ([]datastore.HealthEventWithStatus, error)
 	FindHealthEventsByQueryBatchedFn   func(ctx context.Context, builder datastore.QueryBuilder, batchSize int, fn func([]datastore.HealthEventWithStatus) error) error
+	UpdateHealthEventsByQueryFn        func(ctx context.Context, queryBuilder datastore.QueryBuilder, updateBuilder datastore.UpdateBuilder) error
 	updateCalled                       int
 	findHealthEventsByQueryCalls       int
 }
@@ -228,6 +229,10 @@ func (m *MockHealthEventStore) FindHealthEventsByQueryBatched(ctx context.Contex
 }
 
 func (m *MockHealthEventStore) UpdateHealthEventsByQuery(ctx context.Context, queryBuilder datastore.QueryBuilder, updateBuilder datastore.UpdateBuilder) error {
+	if m.UpdateHealthEventsByQueryFn != nil {
+		return m.UpdateHealthEventsByQueryFn(ctx, queryBuilder, updateBuilder)
+	}
+
 	return nil
 }
 
@@ -2182,3 +2187,127 @@ func TestCustomAction_E2E(t *testing.T) {
 
 	_ = testDynamic.Resource(gvr).Delete(ctx, crName, metav1.DeleteOptions{})
 }
+
+// TestComponentReset_E2E verifies that a reset-eligible event (COMPONENT_RESET with a GPU_UUID impacted entity)
+// creates a GPUReset CR instead of a RebootNode CR, and that the event is remediated once the GPUReset completes.
+func TestComponentReset_E2E(t *testing.T) {
+	ctx, cancel := context.WithTimeout(testContext, 15*time.Second)
+	defer cancel()
+
+	nodeName := "test-node-component-reset-e2e"
+	gpuUUID := "GPU-6b1f3a2e-8c41-4d0e-9a7b-2f6c1e5d9b01"
+	createTestNode(ctx, nodeName, nil, map[string]string{"test": "label"})
+	defer func() {
+		_ = testClient.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	}()
+
+	remediationClient, err := createTestRemediationClient(false, restartAndResetRemediationActions)
+	require.NoError(t, err)
+
+	var remediatedStatuses []bool
+	resetMockStore := &MockHealthEventStore{}
+	resetMockStore.UpdateHealthEventStatusFn = func(ctx context.Context, id string, status datastore.HealthEventStatus) error {
+		if status.FaultRemediated != nil {
+			remediatedStatuses = append(remediatedStatuses, *status.FaultRemediated)
+		}
+		return nil
+	}
+
+	resetWatcher := NewMockChangeStreamWatcher()
+
+	cfg := ReconcilerConfig{
+		RemediationClient: remediationClient,
+		StateManager:      statemanager.NewStateManager(testClient),
+		UpdateMaxRetries:  3,
+		UpdateRetryDelay:  100 * time.Millisecond,
+	}
+
+	resetReconciler := NewFaultRemediationReconciler(nil, resetWatcher, resetMockStore, cfg, false)
+
+	gpuResetGVR := schema.GroupVersionResource{
+		Group:    "janitor.dgxc.nvidia.com",
+		Version:  "v1alpha1",
+		Resource: "gpuresets",
+	}
+	rebootNodeGVR := schema.GroupVersionResource{
+		Group:    "janitor.dgxc.nvidia.com",
+		Version:  "v1alpha1",
+		Resource: "rebootnodes",
+	}
+
+	// Pre-set the node state to match what fault-quarantine + node-drainer would have done
+	_, err = cfg.StateManager.UpdateNVSentinelStateNodeLabel(ctx, nodeName,
+		statemanager.QuarantinedLabelValue, false)
+	require.NoError(t, err)
+	_, err = cfg.StateManager.UpdateNVSentinelStateNodeLabel(ctx, nodeName,
+		statemanager.DrainingLabelValue, false)
+	require.NoError(t, err)
+	_, err = cfg.StateManager.UpdateNVSentinelStateNodeLabel(ctx, nodeName,
+		statemanager.DrainSucceededLabelValue, false)
+	require.NoError(t, err)
+
+	rawEvent := createQuarantineEvent("component-reset-e2e-event-1", nodeName,
+		protos.RecommendedAction_COMPONENT_RESET)
+	healthEvent := rawEvent["fullDocument"].(map[string]interface{})["healthevent"].(map[string]interface{})
+	healthEvent["entitiesimpacted"] = []interface{}{
+		datastore.Event{"entitytype": "GPU_UUID", "entityvalue": gpuUUID},
+	}
+	eventToken := datastore.EventWithToken{
+		Event:       map[string]interface{}(rawEvent),
+		ResumeToken: []byte("component-reset-token-1"),
+	}
+
+	_, err = resetReconciler.Reconcile(ctx, &eventToken)
+	require.NoError(t, err)
+
+	resetGroup := "reset-" + gpuUUID
+	state, _, err := resetReconciler.annotationManager.GetRemediationState(ctx, nodeName)
+	require.NoError(t, err)
+	require.Contains(t, state.EquivalenceGroups, resetGroup, "Should have GPU-scoped reset equivalence group")
+	assert.NotContains(t, state.EquivalenceGroups, "restart", "Should not have restart equivalence group")
+
+	crName := state.EquivalenceGroups[resetGroup].MaintenanceCR
+	assert.Equal(t, protos.RecommendedAction_COMPONENT_RESET.String(), state.EquivalenceGroups[resetGroup].ActionName)
+
+	cr, err := testDynamic.Resource(gpuResetGVR).Get(ctx, crName, metav1.GetOptions{})
+	require.NoError(t, err, "GPUReset CR should exist in Kubernetes")
+	assert.Equal(t, "GPUReset", cr.GetKind())
+
+	spec := cr.Object["spec"].(map[string]interface{})
+	assert.Equal(t, nodeName, spec["nodeName"])
+	assert.Equal(t, []interface{}{gpuUUID}, spec["selector"].(map[string]interface{})["uuids"])
+
+	_, err = testDynamic.Resource(rebootNodeGVR).Get(ctx, crName, metav1.GetOptions{})
+	assert.Error(t, err, "RebootNode CR should not be created for a reset-eligible event")
+
+	require.Equal(t, []bool{true}, remediatedStatuses, "Event should be marked as remediated")
+
+	node, err := testClient.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, string(statemanager.RemediationSucceededLabelValue),
+		node.Labels[statemanager.NVSentinelStateLabelKey])
+
+	// A repeated event for the same GPU is deduplicated while the reset is in progress
+	repeatedEvent := &protos.HealthEvent{
+		NodeName:          nodeName,
+		RecommendedAction: protos.RecommendedAction_COMPONENT_RESET,
+		EntitiesImpacted:  []*protos.Entity{{EntityType: "GPU_UUID", EntityValue: gpuUUID}},
+	}
+	groupConfig, err := common.GetGroupConfigForEvent(remediationClient.GetConfig().RemediationActions, repeatedEvent)
+	require.NoError(t, err)
+
+	shouldCreate, existingCR, err := resetReconciler.checkExistingCRStatus(ctx, repeatedEvent, groupConfig)
+	require.NoError(t, err)
+	assert.False(t, shouldCreate, "In-progress GPUReset should block a new CR")
+	assert.Equal(t, crName, existingCR)
+
+	// Once the GPUReset completes, the equivalence group is released
+	updateGPUResetStatus(ctx, t, crName, "Succeeded")
+
+	assert.Eventually(t, func() bool {
+		shouldCreate, _, err = resetReconciler.checkExistingCRStatus(ctx, repeatedEvent, groupConfig)
+		return err == nil && shouldCreate
+	}, 5*time.Second, 100*time.Millisecond, "Completed GPUReset should allow a new CR")
+
+	_ = testDynamic.Resource(gpuResetGVR).Delete(ctx, crName, metav1.DeleteOptions{})
+}
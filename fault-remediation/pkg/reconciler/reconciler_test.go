@@ -24,6 +24,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
@@ -34,6 +35,8 @@ import (
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/config"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/crstatus"
 	"github.com/nvidia/nvsentinel/fault-remediation/pkg/events"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/metrics"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/publisher"
 	"github.com/nvidia/nvsentinel/store-client/pkg/client"
 	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
 )
@@ -124,14 +127,17 @@ func (w *MockCRStatusCheckerWrapper) IsSuccessful(ctx context.Context, crName st
 }
 
 type MockNodeAnnotationManager struct {
-	existingCRs map[string]string
+	existingCRs    map[string]string
+	history        *annotation.RemediationHistoryAnnotation
+	node           *corev1.Node
+	deferredTaints []string
 }
 
 func (m *MockNodeAnnotationManager) GetRemediationState(ctx context.Context, nodeName string) (*annotation.RemediationStateAnnotation, *corev1.Node, error) {
 	if m.existingCRs == nil {
 		return &annotation.RemediationStateAnnotation{
 			EquivalenceGroups: make(map[string]annotation.EquivalenceGroupState),
-		}, nil, nil
+		}, m.node, nil
 	}
 
 	annotationState := &annotation.RemediationStateAnnotation{
@@ -143,7 +149,7 @@ func (m *MockNodeAnnotationManager) GetRemediationState(ctx context.Context, nod
 			CreatedAt:     time.Now(),
 		}
 	}
-	return annotationState, nil, nil
+	return annotationState, m.node, nil
 }
 
 func (m *MockNodeAnnotationManager) UpdateRemediationState(ctx context.Context, nodeName string,
@@ -159,6 +165,24 @@ func (m *MockNodeAnnotationManager) RemoveGroupsFromState(ctx context.Context, n
 	return nil
 }
 
+func (m *MockNodeAnnotationManager) GetRemediationHistory(ctx context.Context, nodeName string) (*annotation.RemediationHistoryAnnotation, error) {
+	if m.history != nil {
+		return m.history, nil
+	}
+	return &annotation.RemediationHistoryAnnotation{}, nil
+}
+
+func (m *MockNodeAnnotationManager) RecordRemediation(ctx context.Context, nodeName string,
+	actionName string, crName string, gpuUUID string, outcome string) error {
+	return nil
+}
+
+func (m *MockNodeAnnotationManager) SetRemediationDeferredCondition(ctx context.Context, nodeName string,
+	taintKeys []string) error {
+	m.deferredTaints = taintKeys
+	return nil
+}
+
 func (m *MockDatabaseClient) UpdateDocument(ctx context.Context, filter interface{}, update interface{}) (*client.UpdateResult, error) {
 	if m.updateDocumentFn != nil {
 		return m.updateDocumentFn(ctx, filter, update)
@@ -675,6 +699,143 @@ func TestShouldSkipEvent(t *testing.T) {
 	})
 }
 
+func TestGPUCooldownRemaining(t *testing.T) {
+	annotationManager := &MockNodeAnnotationManager{
+		history: &annotation.RemediationHistoryAnnotation{
+			GPULastRemediatedAt: map[string]time.Time{
+				"GPU-123": time.Now().Add(-5 * time.Minute),
+				"GPU-789": time.Now().Add(-2 * time.Hour),
+			},
+		},
+	}
+	mockK8sClient := &MockK8sClient{annotationManagerOverride: annotationManager}
+
+	cfg := ReconcilerConfig{
+		RemediationClient:      mockK8sClient,
+		StateManager:           &statemanager.MockStateManager{},
+		GPURemediationCooldown: time.Hour,
+	}
+	r := NewFaultRemediationReconciler(nil, nil, nil, cfg, false)
+
+	tests := []struct {
+		name        string
+		gpuUUID     string
+		cooldown    time.Duration
+		shouldDefer bool
+	}{
+		{
+			name:        "Defer reset of recently remediated GPU",
+			gpuUUID:     "GPU-123",
+			cooldown:    time.Hour,
+			shouldDefer: true,
+		},
+		{
+			name:        "Process reset of a different GPU",
+			gpuUUID:     "GPU-456",
+			cooldown:    time.Hour,
+			shouldDefer: false,
+		},
+		{
+			name:        "Process reset once the cooldown has elapsed",
+			gpuUUID:     "GPU-789",
+			cooldown:    time.Hour,
+			shouldDefer: false,
+		},
+		{
+			name:        "Process reset when the cooldown is disabled",
+			gpuUUID:     "GPU-123",
+			cooldown:    0,
+			shouldDefer: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r.Config.GPURemediationCooldown = tt.cooldown
+
+			healthEventWithStatus := model.HealthEventWithStatus{
+				HealthEvent: &protos.HealthEvent{
+					NodeName:          "test-node",
+					RecommendedAction: protos.RecommendedAction_COMPONENT_RESET,
+				},
+			}
+			groupConfig := &common.EquivalenceGroupConfig{
+				EffectiveEquivalenceGroup: "reset-" + tt.gpuUUID,
+				ImpactedEntityScopeValue:  tt.gpuUUID,
+			}
+
+			assert.False(t, r.shouldSkipEvent(t.Context(), healthEventWithStatus, groupConfig),
+				"events in cooldown must not be skipped, or they are never remediated")
+
+			remaining := r.gpuCooldownRemaining(t.Context(), "test-node", groupConfig)
+			if !tt.shouldDefer {
+				assert.Zero(t, remaining)
+				return
+			}
+
+			assert.Greater(t, remaining, 54*time.Minute, "requeue should wait out the rest of the cooldown")
+			assert.LessOrEqual(t, remaining, 55*time.Minute)
+		})
+	}
+
+	t.Run("Node-scoped actions ignore the GPU cooldown", func(t *testing.T) {
+		r.Config.GPURemediationCooldown = time.Hour
+
+		assert.Zero(t, r.gpuCooldownRemaining(t.Context(), "test-node", getGroupConfig("restart", nil)))
+	})
+}
+
+func TestDeferForNodeTaints(t *testing.T) {
+	const maintenanceTaint = "example.com/maintenance"
+
+	annotationManager := &MockNodeAnnotationManager{
+		node: &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-node"},
+			Spec: corev1.NodeSpec{
+				Taints: []corev1.Taint{
+					{Key: maintenanceTaint, Effect: corev1.TaintEffectNoSchedule},
+					{Key: "node.kubernetes.io/unschedulable", Effect: corev1.TaintEffectNoSchedule},
+				},
+			},
+		},
+	}
+	mockK8sClient := &MockK8sClient{annotationManagerOverride: annotationManager}
+
+	cfg := ReconcilerConfig{
+		RemediationClient:         mockK8sClient,
+		StateManager:              &statemanager.MockStateManager{},
+		DeferRemediationTaintKeys: []string{maintenanceTaint},
+	}
+	r := NewFaultRemediationReconciler(nil, nil, nil, cfg, false)
+
+	t.Run("Defer while the configured taint is present", func(t *testing.T) {
+		assert.True(t, r.deferForNodeTaints(t.Context(), "test-node"))
+		assert.Equal(t, []string{maintenanceTaint}, annotationManager.deferredTaints,
+			"deferred condition should name the taint")
+	})
+
+	t.Run("Proceed once the taint is removed", func(t *testing.T) {
+		annotationManager.node.Spec.Taints = annotationManager.node.Spec.Taints[1:]
+
+		assert.False(t, r.deferForNodeTaints(t.Context(), "test-node"))
+		assert.Empty(t, annotationManager.deferredTaints, "deferred condition should be cleared")
+	})
+
+	t.Run("Ignore taints that are not configured", func(t *testing.T) {
+		r.Config.DeferRemediationTaintKeys = []string{"example.com/other"}
+		annotationManager.node.Spec.Taints = append(annotationManager.node.Spec.Taints,
+			corev1.Taint{Key: maintenanceTaint, Effect: corev1.TaintEffectNoSchedule})
+
+		assert.False(t, r.deferForNodeTaints(t.Context(), "test-node"))
+	})
+
+	t.Run("Never defer when no taint keys are configured", func(t *testing.T) {
+		r.Config.DeferRemediationTaintKeys = nil
+
+		assert.False(t, r.deferForNodeTaints(t.Context(), "test-node"))
+	})
+}
+
 func TestRunLogCollectorOnNoneActionWhenEnabled(t *testing.T) {
 	ctx := context.Background()
 
@@ -928,6 +1089,234 @@ func TestUpdateNodeRemediatedStatus(t *testing.T) {
 	}
 }
 
+// failingAnnotationManager fails every remediation state lookup so that reconciles always error.
+type failingAnnotationManager struct {
+	MockNodeAnnotationManager
+}
+
+func (m *failingAnnotationManager) GetRemediationState(ctx context.Context,
+	nodeName string) (*annotation.RemediationStateAnnotation, *corev1.Node, error) {
+	return nil, nil, errors.New("api server unavailable")
+}
+
+func (m *failingAnnotationManager) ClearRemediationState(ctx context.Context, nodeName string) error {
+	return errors.New("api server unavailable")
+}
+
+func TestReconcileDeadLettersAfterMaxRetries(t *testing.T) {
+	ctx := context.Background()
+	nodeName := "node-dead-letter"
+	maxRetries := 3
+
+	var (
+		remediatedStatus *bool
+		lastError        interface{}
+		stateLabel       statemanager.NVSentinelStateLabelValue
+	)
+
+	healthStore := &MockHealthEventStore{
+		UpdateHealthEventStatusFn: func(ctx context.Context, id string, status datastore.HealthEventStatus) error {
+			remediatedStatus = status.FaultRemediated
+			return nil
+		},
+		UpdateHealthEventsByQueryFn: func(ctx context.Context, queryBuilder datastore.QueryBuilder,
+			updateBuilder datastore.UpdateBuilder) error {
+			assert.Equal(t, map[string]interface{}{"_id": "dead-letter-event"}, queryBuilder.ToMongo())
+			set := updateBuilder.ToMongo()["$set"].(map[string]interface{})
+			lastError = set[lastErrorField]
+			return nil
+		},
+	}
+	stateManager := &statemanager.MockStateManager{
+		UpdateNVSentinelStateNodeLabelFn: func(ctx context.Context, nodeName string,
+			newStateLabelValue statemanager.NVSentinelStateLabelValue, removeStateLabel bool) (bool, error) {
+			stateLabel = newStateLabelValue
+			return true, nil
+		},
+	}
+	cfg := ReconcilerConfig{
+		RemediationClient: &MockK8sClient{
+			annotationManagerOverride: &failingAnnotationManager{},
+		},
+		StateManager:        stateManager,
+		MaxReconcileRetries: maxRetries,
+	}
+	watcher := NewMockChangeStreamWatcher()
+	r := NewFaultRemediationReconciler(nil, watcher, healthStore, cfg, false)
+
+	rawEvent := createQuarantineEvent("dead-letter-event", nodeName, protos.RecommendedAction_RESTART_BM)
+	eventToken := &datastore.EventWithToken{
+		Event:       map[string]interface{}(rawEvent),
+		ResumeToken: []byte("dead-letter-token"),
+	}
+
+	before := getCounterVecValue(t, metrics.EventsDeadLettered, nodeName)
+
+	for attempt := 1; attempt < maxRetries; attempt++ {
+		_, err := r.Reconcile(ctx, eventToken)
+		assert.Error(t, err, "attempt %d should be retried", attempt)
+	}
+
+	assert.Nil(t, remediatedStatus, "event should not be dead-lettered before the threshold")
+	assert.Equal(t, before, getCounterVecValue(t, metrics.EventsDeadLettered, nodeName))
+
+	result, err := r.Reconcile(ctx, eventToken)
+	assert.NoError(t, err, "dead-lettered event should not be requeued")
+	assert.True(t, result.IsZero())
+
+	if assert.NotNil(t, remediatedStatus) {
+		assert.False(t, *remediatedStatus)
+	}
+	assert.Equal(t, "error checking existing CR status: error getting remediation state: api server unavailable",
+		lastError)
+	assert.Equal(t, statemanager.RemediationFailedLabelValue, stateLabel)
+	assert.Equal(t, before+1, getCounterVecValue(t, metrics.EventsDeadLettered, nodeName))
+
+	_, markProcessed, _, _ := watcher.GetCallCounts()
+	assert.Equal(t, 1, markProcessed)
+}
+
+func TestReconcileDoesNotDeadLetterCancellationEvents(t *testing.T) {
+	ctx := context.Background()
+	nodeName := "node-cancel-retry"
+	maxRetries := 3
+
+	healthStore := &MockHealthEventStore{
+		UpdateHealthEventStatusFn: func(ctx context.Context, id string, status datastore.HealthEventStatus) error {
+			t.Fatal("cancellation event must not be marked not remediated")
+			return nil
+		},
+	}
+	stateManager := &statemanager.MockStateManager{
+		UpdateNVSentinelStateNodeLabelFn: func(ctx context.Context, nodeName string,
+			newStateLabelValue statemanager.NVSentinelStateLabelValue, removeStateLabel bool) (bool, error) {
+			t.Fatalf("cancellation event must not set the %s label", newStateLabelValue)
+			return false, nil
+		},
+	}
+	cfg := ReconcilerConfig{
+		RemediationClient: &MockK8sClient{
+			annotationManagerOverride: &failingAnnotationManager{},
+		},
+		StateManager:        stateManager,
+		MaxReconcileRetries: maxRetries,
+	}
+	watcher := NewMockChangeStreamWatcher()
+	r := NewFaultRemediationReconciler(nil, watcher, healthStore, cfg, false)
+
+	rawEvent := createCancelledEvent("cancel-retry-event", nodeName, protos.RecommendedAction_RESTART_BM)
+	eventToken := &datastore.EventWithToken{
+		Event:       map[string]interface{}(rawEvent),
+		ResumeToken: []byte("cancel-retry-token"),
+	}
+
+	before := getCounterVecValue(t, metrics.EventsDeadLettered, nodeName)
+
+	for attempt := 1; attempt <= maxRetries+1; attempt++ {
+		_, err := r.Reconcile(ctx, eventToken)
+		assert.Error(t, err, "attempt %d should be retried", attempt)
+	}
+
+	assert.Equal(t, before, getCounterVecValue(t, metrics.EventsDeadLettered, nodeName))
+
+	_, markProcessed, _, _ := watcher.GetCallCounts()
+	assert.Equal(t, 0, markProcessed)
+}
+
+// recordingPublisher records published transitions and optionally fails every publish.
+type recordingPublisher struct {
+	transitions []publisher.Transition
+	err         error
+}
+
+func (p *recordingPublisher) Publish(ctx context.Context, transition publisher.Transition) error {
+	p.transitions = append(p.transitions, transition)
+	return p.err
+}
+
+func (p *recordingPublisher) Close(ctx context.Context) error {
+	return nil
+}
+
+func TestReconcilePublishesPhaseTransitions(t *testing.T) {
+	ctx := context.Background()
+	nodeName := "node-transitions"
+
+	tests := []struct {
+		name          string
+		event         datastore.Event
+		crErr         error
+		publishErr    error
+		expectedPhase string
+		expectError   bool
+	}{
+		{
+			name:          "maintenance CR created",
+			event:         createQuarantineEvent("evt-remediating", nodeName, protos.RecommendedAction_RESTART_BM),
+			expectedPhase: common.PhaseRemediating,
+		},
+		{
+			name:          "maintenance CR creation failed",
+			event:         createQuarantineEvent("evt-failed", nodeName, protos.RecommendedAction_RESTART_BM),
+			crErr:         errors.New("janitor unavailable"),
+			expectedPhase: common.PhaseRemediationFailed,
+			expectError:   true,
+		},
+		{
+			name:          "event cancelled",
+			event:         createCancelledEvent("evt-cancelled", nodeName, protos.RecommendedAction_RESTART_BM),
+			expectedPhase: common.PhaseCancelled,
+		},
+		{
+			name:          "publish failure does not fail reconcile",
+			event:         createQuarantineEvent("evt-publish-error", nodeName, protos.RecommendedAction_RESTART_BM),
+			publishErr:    publisher.ErrQueueFull,
+			expectedPhase: common.PhaseRemediating,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventPublisher := &recordingPublisher{err: tt.publishErr}
+			cfg := ReconcilerConfig{
+				RemediationClient: &MockK8sClient{
+					createMaintenanceResourceFn: func(ctx context.Context, healthEventDoc *events.HealthEventData,
+						_ *common.EquivalenceGroupConfig) (string, error) {
+						return "test-cr", tt.crErr
+					},
+					annotationManagerOverride: &MockNodeAnnotationManager{},
+				},
+				StateManager: &statemanager.MockStateManager{
+					UpdateNVSentinelStateNodeLabelFn: func(ctx context.Context, nodeName string,
+						newStateLabelValue statemanager.NVSentinelStateLabelValue, removeStateLabel bool) (bool, error) {
+						return true, nil
+					},
+				},
+				Publisher: eventPublisher,
+			}
+			r := NewFaultRemediationReconciler(nil, NewMockChangeStreamWatcher(), &MockHealthEventStore{}, cfg, false)
+
+			_, err := r.Reconcile(ctx, &datastore.EventWithToken{
+				Event:       map[string]interface{}(tt.event),
+				ResumeToken: []byte("token"),
+			})
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if assert.Len(t, eventPublisher.transitions, 1) {
+				transition := eventPublisher.transitions[0]
+				assert.Equal(t, tt.expectedPhase, transition.Phase)
+				assert.Equal(t, nodeName, transition.NodeName)
+				assert.Equal(t, protos.RecommendedAction_RESTART_BM.String(), transition.RecommendedAction)
+				assert.NotEmpty(t, transition.EventID)
+			}
+		})
+	}
+}
+
 func TestCRBasedDeduplication(t *testing.T) {
 	ctx := context.Background()
 
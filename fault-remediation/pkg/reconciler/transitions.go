@@ -0,0 +1,58 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/events"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/publisher"
+)
+
+// publishTransition reports that the event moved to phase. Publishing is best effort and never
+// fails the reconcile.
+func (r *FaultRemediationReconciler) publishTransition(
+	ctx context.Context,
+	healthEventWithStatus *events.HealthEventDoc,
+	phase string,
+	reason string,
+) {
+	if r.Config.Publisher == nil {
+		return
+	}
+
+	healthEvent := healthEventWithStatus.HealthEvent
+	transition := publisher.Transition{
+		EventID:           healthEventWithStatus.ID,
+		NodeName:          healthEvent.GetNodeName(),
+		Phase:             phase,
+		Agent:             healthEvent.GetAgent(),
+		CheckName:         healthEvent.GetCheckName(),
+		RecommendedAction: model.GetEffectiveActionName(healthEvent),
+		Reason:            reason,
+		Timestamp:         time.Now().UTC(),
+	}
+
+	if err := r.Config.Publisher.Publish(ctx, transition); err != nil {
+		slog.WarnContext(ctx, "Failed to queue phase transition for publishing",
+			"id", transition.EventID,
+			"node", transition.NodeName,
+			"phase", phase,
+			"error", err)
+	}
+}
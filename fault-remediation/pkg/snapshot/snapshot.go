@@ -0,0 +1,365 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package snapshot dumps the current state of the remediation pipeline as a single
+// JSON document for support bundles.
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/eventutil"
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/common"
+	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
+	"github.com/nvidia/nvsentinel/store-client/pkg/query"
+	"github.com/nvidia/nvsentinel/store-client/pkg/utils"
+)
+
+// Path is the HTTP path the snapshot is served on.
+const Path = "/debug/snapshot"
+
+const (
+	// cacheTTL bounds how often repeated requests hit the datastore and the API server.
+	cacheTTL = 10 * time.Second
+	// nodeListPageSize is the page size used when listing nodes from the API server.
+	nodeListPageSize = 500
+	// maxEvents caps the number of events in a snapshot.
+	maxEvents = 1000
+	// eventBatchSize is the batch size used when reading events from the datastore.
+	eventBatchSize = 100
+)
+
+// errEventLimitReached stops the event query once maxEvents have been collected.
+var errEventLimitReached = errors.New("snapshot event limit reached")
+
+// RebootNodeListGVK is the list kind of the janitor RebootNode CRs included in the snapshot.
+var RebootNodeListGVK = schema.GroupVersionKind{
+	Group:   "janitor.dgxc.nvidia.com",
+	Version: "v1alpha1",
+	Kind:    "RebootNodeList",
+}
+
+// Snapshot is the JSON document returned by the snapshot endpoint. EventsTruncated is set
+// when more than maxEvents events are in the pipeline and only the first ones are listed.
+type Snapshot struct {
+	GeneratedAt     time.Time    `json:"generatedAt"`
+	Events          []Event      `json:"events"`
+	EventsTruncated bool         `json:"eventsTruncated,omitempty"`
+	RebootNodes     []RebootNode `json:"rebootNodes"`
+	Nodes           []Node       `json:"nodes"`
+}
+
+// Event is a quarantined health event that has not finished remediation, with its pipeline phase.
+type Event struct {
+	ID                string    `json:"id"`
+	NodeName          string    `json:"nodeName"`
+	Agent             string    `json:"agent"`
+	CheckName         string    `json:"checkName"`
+	IsFatal           bool      `json:"isFatal"`
+	RecommendedAction string    `json:"recommendedAction"`
+	CreatedAt         time.Time `json:"createdAt"`
+	Phase             string    `json:"phase"`
+	NodeQuarantined   string    `json:"nodeQuarantined"`
+	EvictionStatus    string    `json:"evictionStatus,omitempty"`
+}
+
+// RebootNode summarizes a janitor RebootNode CR.
+type RebootNode struct {
+	Name     string         `json:"name"`
+	NodeName string         `json:"nodeName"`
+	Status   map[string]any `json:"status,omitempty"`
+}
+
+// Node is the cordon state of a node that is cordoned or referenced by an event or CR.
+type Node struct {
+	Name          string `json:"name"`
+	Unschedulable bool   `json:"unschedulable"`
+	State         string `json:"state,omitempty"`
+}
+
+// Collector assembles snapshots from the health event store and the Kubernetes API.
+// Snapshots are cached for cacheTTL so that repeated requests do not list the cluster
+// on every call.
+type Collector struct {
+	store  datastore.HealthEventStore
+	client client.Reader
+	now    func() time.Time
+
+	mu       sync.Mutex
+	cached   *Snapshot
+	cachedAt time.Time
+}
+
+// NewCollector creates a Collector reading events from store and cluster objects from c.
+func NewCollector(store datastore.HealthEventStore, c client.Reader) *Collector {
+	return &Collector{
+		store:  store,
+		client: c,
+		now:    time.Now,
+	}
+}
+
+// Collect returns a snapshot of the events still in the pipeline, the RebootNode CRs and
+// the cordon state of the nodes involved. A snapshot younger than cacheTTL is reused.
+func (c *Collector) Collect(ctx context.Context) (*Snapshot, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if c.cached != nil && now.Sub(c.cachedAt) < cacheTTL {
+		return c.cached, nil
+	}
+
+	snap, err := c.collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cached = snap
+	c.cachedAt = now
+
+	return snap, nil
+}
+
+func (c *Collector) collect(ctx context.Context) (*Snapshot, error) {
+	events, truncated, err := c.collectEvents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rebootNodes, err := c.collectRebootNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := make(map[string]bool)
+	for _, e := range events {
+		referenced[e.NodeName] = true
+	}
+
+	for _, rn := range rebootNodes {
+		referenced[rn.NodeName] = true
+	}
+
+	nodes, err := c.collectNodes(ctx, referenced)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Snapshot{
+		GeneratedAt:     c.now().UTC(),
+		Events:          events,
+		EventsTruncated: truncated,
+		RebootNodes:     rebootNodes,
+		Nodes:           nodes,
+	}, nil
+}
+
+// collectEvents returns the quarantined events that have not been remediated or cancelled,
+// using the same filter as the cold start, and reports whether the list was cut at maxEvents.
+func (c *Collector) collectEvents(ctx context.Context) ([]Event, bool, error) {
+	q := query.New().Build(
+		query.And(
+			query.In("healtheventstatus.nodequarantined",
+				[]interface{}{string(model.Quarantined), string(model.AlreadyQuarantined)}),
+			query.Eq("healtheventstatus.faultremediated", nil),
+		),
+	)
+
+	events := []Event{}
+	truncated := false
+
+	err := c.store.FindHealthEventsByQueryBatched(ctx, q, eventBatchSize,
+		func(batch []datastore.HealthEventWithStatus) error {
+			for _, doc := range batch {
+				if len(events) >= maxEvents {
+					truncated = true
+					return errEventLimitReached
+				}
+
+				if len(doc.RawEvent) == 0 {
+					continue
+				}
+
+				he, err := eventutil.ParseHealthEventFromEvent(doc.RawEvent)
+				if err != nil {
+					slog.Warn("Skipping unparsable health event in snapshot", "error", err)
+					continue
+				}
+
+				id, _ := utils.ExtractDocumentID(doc.RawEvent)
+
+				events = append(events, newEvent(id, he))
+			}
+
+			return nil
+		})
+	if err != nil && !errors.Is(err, errEventLimitReached) {
+		return nil, false, fmt.Errorf("failed to query health events: %w", err)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+
+	return events, truncated, nil
+}
+
+func newEvent(id string, he model.HealthEventWithStatus) Event {
+	e := Event{
+		ID:                id,
+		NodeName:          he.HealthEvent.GetNodeName(),
+		Agent:             he.HealthEvent.GetAgent(),
+		CheckName:         he.HealthEvent.GetCheckName(),
+		IsFatal:           he.HealthEvent.GetIsFatal(),
+		RecommendedAction: model.GetEffectiveActionName(he.HealthEvent),
+		CreatedAt:         he.CreatedAt,
+		Phase:             common.PhaseDraining,
+	}
+
+	status := he.HealthEventStatus
+	if status == nil {
+		return e
+	}
+
+	e.NodeQuarantined = status.GetNodeQuarantined()
+	e.EvictionStatus = status.GetUserPodsEvictionStatus().GetStatus()
+
+	// Quarantined events are draining until their pods are evicted, then wait for remediation.
+	switch model.Status(e.EvictionStatus) {
+	case model.StatusSucceeded, model.AlreadyDrained:
+		e.Phase = common.PhaseRemediating
+	}
+
+	return e
+}
+
+func (c *Collector) collectRebootNodes(ctx context.Context) ([]RebootNode, error) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(RebootNodeListGVK)
+
+	if err := c.client.List(ctx, list); err != nil {
+		if meta.IsNoMatchError(err) {
+			slog.Info("RebootNode CRD not installed, omitting RebootNodes from snapshot")
+			return []RebootNode{}, nil
+		}
+
+		return nil, fmt.Errorf("failed to list RebootNodes: %w", err)
+	}
+
+	rebootNodes := make([]RebootNode, 0, len(list.Items))
+
+	for _, item := range list.Items {
+		nodeName, _, _ := unstructured.NestedString(item.Object, "spec", "nodeName")
+		status, _, _ := unstructured.NestedMap(item.Object, "status")
+
+		rebootNodes = append(rebootNodes, RebootNode{
+			Name:     item.GetName(),
+			NodeName: nodeName,
+			Status:   status,
+		})
+	}
+
+	sort.Slice(rebootNodes, func(i, j int) bool {
+		return rebootNodes[i].Name < rebootNodes[j].Name
+	})
+
+	return rebootNodes, nil
+}
+
+func (c *Collector) collectNodes(ctx context.Context, referenced map[string]bool) ([]Node, error) {
+	nodes := []Node{}
+
+	var list corev1.NodeList
+
+	for {
+		if err := c.client.List(ctx, &list,
+			client.Limit(nodeListPageSize), client.Continue(list.Continue)); err != nil {
+			return nil, fmt.Errorf("failed to list nodes: %w", err)
+		}
+
+		for _, n := range list.Items {
+			state := n.Labels[statemanager.NVSentinelStateLabelKey]
+			if !n.Spec.Unschedulable && state == "" && !referenced[n.Name] {
+				continue
+			}
+
+			nodes = append(nodes, Node{
+				Name:          n.Name,
+				Unschedulable: n.Spec.Unschedulable,
+				State:         state,
+			})
+		}
+
+		if list.Continue == "" {
+			break
+		}
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	return nodes, nil
+}
+
+// Handler serves snapshots as JSON. It responds with 503 until a Collector is set,
+// because the datastore is initialized after the HTTP endpoints start serving.
+type Handler struct {
+	collector atomic.Pointer[Collector]
+}
+
+// SetCollector makes the handler serve snapshots from c.
+func (h *Handler) SetCollector(c *Collector) {
+	h.collector.Store(c)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c := h.collector.Load()
+	if c == nil {
+		http.Error(w, "datastore not initialized", http.StatusServiceUnavailable)
+		return
+	}
+
+	snap, err := c.Collect(r.Context())
+	if err != nil {
+		slog.Error("Failed to collect pipeline snapshot", "error", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(snap); err != nil {
+		slog.Error("Failed to encode pipeline snapshot as JSON", "error", err)
+	}
+}
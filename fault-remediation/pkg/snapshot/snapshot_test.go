@@ -0,0 +1,371 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
+	"github.com/nvidia/nvsentinel/fault-remediation/pkg/common"
+	"github.com/nvidia/nvsentinel/store-client/pkg/datastore"
+)
+
+// fakeHealthEventStore serves the events matching the query's Mongo filter from a fixed set.
+type fakeHealthEventStore struct {
+	datastore.HealthEventStore
+	events []datastore.HealthEventWithStatus
+}
+
+func (f *fakeHealthEventStore) FindHealthEventsByQueryBatched(
+	_ context.Context, builder datastore.QueryBuilder, batchSize int,
+	fn func([]datastore.HealthEventWithStatus) error,
+) error {
+	filter := builder.ToMongo()
+
+	var batch []datastore.HealthEventWithStatus
+
+	for _, e := range f.events {
+		if !matchesFilter(map[string]any(e.RawEvent), filter) {
+			continue
+		}
+
+		batch = append(batch, e)
+
+		if len(batch) == batchSize {
+			if err := fn(batch); err != nil {
+				return err
+			}
+
+			batch = nil
+		}
+	}
+
+	if len(batch) > 0 {
+		return fn(batch)
+	}
+
+	return nil
+}
+
+// matchesFilter evaluates the subset of Mongo filters built by the query package: $and, $or,
+// $in and equality, where a nil value matches a missing field.
+func matchesFilter(doc map[string]any, filter map[string]any) bool {
+	for key, cond := range filter {
+		switch key {
+		case "$and", "$or":
+			matched := 0
+
+			for _, sub := range cond.([]any) {
+				if matchesFilter(doc, sub.(map[string]any)) {
+					matched++
+				}
+			}
+
+			if key == "$and" && matched != len(cond.([]any)) || key == "$or" && matched == 0 {
+				return false
+			}
+
+			continue
+		}
+
+		value := lookupField(doc, key)
+
+		if op, ok := cond.(map[string]any); ok {
+			if !slices.Contains(op["$in"].([]any), value) {
+				return false
+			}
+
+			continue
+		}
+
+		if value != cond {
+			return false
+		}
+	}
+
+	return true
+}
+
+// lookupField resolves a dotted, lower-case field path, matching document keys case-insensitively.
+func lookupField(doc map[string]any, path string) any {
+	var current any = doc
+
+	for _, part := range strings.Split(path, ".") {
+		fields, ok := current.(map[string]any)
+		if !ok {
+			return nil
+		}
+
+		current = nil
+
+		for k, v := range fields {
+			if strings.EqualFold(k, part) {
+				current = v
+				break
+			}
+		}
+	}
+
+	return current
+}
+
+func rawEvent(id, node string, createdAt time.Time, status map[string]any) datastore.HealthEventWithStatus {
+	return datastore.HealthEventWithStatus{
+		RawEvent: datastore.Event{
+			"_id":       id,
+			"createdAt": createdAt.Format(time.RFC3339),
+			"healthevent": map[string]any{
+				"nodeName":  node,
+				"agent":     "syslog-health-monitor",
+				"checkName": "SysLogsXIDError",
+				"isFatal":   true,
+			},
+			"healtheventstatus": status,
+		},
+	}
+}
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	s := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(s))
+
+	rebootNodeGVK := RebootNodeListGVK.GroupVersion().WithKind("RebootNode")
+	s.AddKnownTypeWithName(rebootNodeGVK, &unstructured.Unstructured{})
+	s.AddKnownTypeWithName(RebootNodeListGVK, &unstructured.UnstructuredList{})
+
+	return s
+}
+
+func newRebootNode(name, node string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(RebootNodeListGVK.GroupVersion().WithKind("RebootNode"))
+	obj.SetName(name)
+	_ = unstructured.SetNestedField(obj.Object, node, "spec", "nodeName")
+	_ = unstructured.SetNestedField(obj.Object, "2025-01-01T00:00:00Z", "status", "startTime")
+
+	return obj
+}
+
+func TestCollect(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := &fakeHealthEventStore{events: []datastore.HealthEventWithStatus{
+		rawEvent("evt-remediating", "node-c", base.Add(2*time.Minute), map[string]any{
+			"nodeQuarantined":        "Quarantined",
+			"userPodsEvictionStatus": map[string]any{"status": "Succeeded"},
+		}),
+		rawEvent("evt-not-quarantined", "node-a", base, map[string]any{}),
+		rawEvent("evt-draining", "node-b", base.Add(time.Minute), map[string]any{
+			"nodeQuarantined":        "AlreadyQuarantined",
+			"userPodsEvictionStatus": map[string]any{"status": "InProgress"},
+		}),
+		rawEvent("evt-cancelled", "node-d", base.Add(3*time.Minute), map[string]any{
+			"nodeQuarantined": "Cancelled",
+		}),
+		rawEvent("evt-remediated", "node-e", base.Add(4*time.Minute), map[string]any{
+			"nodeQuarantined":        "Quarantined",
+			"userPodsEvictionStatus": map[string]any{"status": "Succeeded"},
+			"faultRemediated":        true,
+		}),
+	}}
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithObjects(
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-a"}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-b"}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-c",
+				Labels: map[string]string{statemanager.NVSentinelStateLabelKey: "remediating"},
+			}, Spec: corev1.NodeSpec{Unschedulable: true}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-f"}, Spec: corev1.NodeSpec{Unschedulable: true}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "healthy"}},
+			newRebootNode("maintenance-node-c", "node-c"),
+		).
+		Build()
+
+	collector := NewCollector(store, k8sClient)
+	collector.now = func() time.Time { return base.Add(time.Hour) }
+
+	snap, err := collector.Collect(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, base.Add(time.Hour), snap.GeneratedAt)
+	assert.False(t, snap.EventsTruncated)
+
+	phases := make(map[string]string, len(snap.Events))
+	for _, e := range snap.Events {
+		phases[e.ID] = e.Phase
+	}
+
+	assert.Equal(t, map[string]string{
+		"evt-draining":    common.PhaseDraining,
+		"evt-remediating": common.PhaseRemediating,
+	}, phases, "only quarantined, unremediated events are listed")
+
+	require.Len(t, snap.Events, 2)
+	assert.Equal(t, "evt-draining", snap.Events[0].ID, "events should be ordered by creation time")
+	assert.Equal(t, "node-b", snap.Events[0].NodeName)
+	assert.Equal(t, "SysLogsXIDError", snap.Events[0].CheckName)
+
+	require.Len(t, snap.RebootNodes, 1)
+	assert.Equal(t, "maintenance-node-c", snap.RebootNodes[0].Name)
+	assert.Equal(t, "node-c", snap.RebootNodes[0].NodeName)
+	assert.Equal(t, "2025-01-01T00:00:00Z", snap.RebootNodes[0].Status["startTime"])
+
+	nodeNames := make([]string, 0, len(snap.Nodes))
+	for _, n := range snap.Nodes {
+		nodeNames = append(nodeNames, n.Name)
+	}
+
+	assert.Equal(t, []string{"node-b", "node-c", "node-f"}, nodeNames, "unreferenced schedulable nodes are omitted")
+	assert.False(t, snap.Nodes[0].Unschedulable)
+	assert.True(t, snap.Nodes[1].Unschedulable)
+	assert.Equal(t, "remediating", snap.Nodes[1].State)
+}
+
+func TestCollectLimitsEvents(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := &fakeHealthEventStore{}
+	for i := range maxEvents + 5 {
+		store.events = append(store.events, rawEvent(fmt.Sprintf("evt-%d", i), "node-a",
+			base.Add(time.Duration(i)*time.Second), map[string]any{"nodeQuarantined": "Quarantined"}))
+	}
+
+	snap, err := NewCollector(store, fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()).
+		Collect(context.Background())
+	require.NoError(t, err)
+
+	assert.Len(t, snap.Events, maxEvents)
+	assert.True(t, snap.EventsTruncated)
+}
+
+func TestCollectCachesSnapshot(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := base
+
+	store := &fakeHealthEventStore{events: []datastore.HealthEventWithStatus{
+		rawEvent("evt-1", "node-a", base, map[string]any{"nodeQuarantined": "Quarantined"}),
+	}}
+
+	collector := NewCollector(store, fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build())
+	collector.now = func() time.Time { return now }
+
+	first, err := collector.Collect(context.Background())
+	require.NoError(t, err)
+	require.Len(t, first.Events, 1)
+
+	store.events = append(store.events, rawEvent("evt-2", "node-b", base, map[string]any{"nodeQuarantined": "Quarantined"}))
+	now = base.Add(cacheTTL - time.Second)
+
+	cached, err := collector.Collect(context.Background())
+	require.NoError(t, err)
+	assert.Same(t, first, cached, "snapshot within the TTL should be reused")
+
+	now = base.Add(cacheTTL)
+
+	refreshed, err := collector.Collect(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, refreshed.Events, 2, "snapshot past the TTL should be collected again")
+}
+
+func TestCollectPagesNodes(t *testing.T) {
+	var continues []string
+
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(newTestScheme(t)).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				nodeList, ok := list.(*corev1.NodeList)
+				if !ok {
+					return c.List(ctx, list, opts...)
+				}
+
+				listOpts := &client.ListOptions{}
+				listOpts.ApplyOptions(opts)
+				assert.Equal(t, int64(nodeListPageSize), listOpts.Limit)
+
+				continues = append(continues, listOpts.Continue)
+
+				if listOpts.Continue == "" {
+					nodeList.Items = []corev1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "node-a"},
+						Spec: corev1.NodeSpec{Unschedulable: true}}}
+					nodeList.Continue = "page-2"
+
+					return nil
+				}
+
+				nodeList.Items = []corev1.Node{{ObjectMeta: metav1.ObjectMeta{Name: "node-b"},
+					Spec: corev1.NodeSpec{Unschedulable: true}}}
+				nodeList.Continue = ""
+
+				return nil
+			},
+		}).
+		Build()
+
+	snap, err := NewCollector(&fakeHealthEventStore{}, k8sClient).Collect(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"", "page-2"}, continues)
+	require.Len(t, snap.Nodes, 2)
+	assert.Equal(t, "node-a", snap.Nodes[0].Name)
+	assert.Equal(t, "node-b", snap.Nodes[1].Name)
+}
+
+func TestHandler(t *testing.T) {
+	h := &Handler{}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code, "no collector set yet")
+
+	store := &fakeHealthEventStore{events: []datastore.HealthEventWithStatus{
+		rawEvent("evt-1", "node-a", time.Now(), map[string]any{"nodeQuarantined": "Quarantined"}),
+	}}
+	h.SetCollector(NewCollector(store, fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()))
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var snap Snapshot
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &snap))
+	require.Len(t, snap.Events, 1)
+	assert.Equal(t, "evt-1", snap.Events[0].ID)
+	assert.Equal(t, common.PhaseDraining, snap.Events[0].Phase)
+}
@@ -37,6 +37,7 @@ import (
 	gcpclient "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/csp/gcp"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/datastore"
 	eventpkg "github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/event"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/eventstream"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/metrics"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
 )
@@ -73,6 +74,7 @@ func startActiveMonitorAndLog(
 	ctx context.Context,
 	wg *sync.WaitGroup,
 	activeMonitor csp.Monitor,
+	pollStartupJitter time.Duration,
 	eventChan chan<- model.MaintenanceEvent,
 ) {
 	if activeMonitor == nil {
@@ -90,7 +92,11 @@ func startActiveMonitorAndLog(
 
 		slog.Info("Starting active monitor", "name", activeMonitor.GetName())
 
-		monitorErr := activeMonitor.StartMonitoring(ctx, eventChan)
+		// Offset the first poll per replica so monitors restarted together do not
+		// hit the CSP API at the same instant.
+		delay := csp.PollStartupDelay(os.Getenv("POD_NAME"), pollStartupJitter)
+
+		monitorErr := csp.StartMonitoringWithDelay(ctx, activeMonitor, delay, eventChan)
 		if monitorErr != nil {
 			if !errors.Is(monitorErr, context.Canceled) && !errors.Is(monitorErr, context.DeadlineExceeded) {
 				metrics.CSPMonitorErrors.WithLabelValues(string(activeMonitor.GetName()), "runtime_error").Inc()
@@ -160,6 +166,17 @@ func run() error {
 		return nil
 	})
 
+	// Optional gRPC stream for consumers outside the process. Nil when disabled.
+	var streamServer *eventstream.Server
+
+	if cfg.EventStream.Enabled {
+		streamServer = eventstream.NewServer()
+
+		g.Go(func() error {
+			return streamServer.Serve(gCtx, cfg.EventStream.Port)
+		})
+	}
+
 	g.Go(func() error {
 		slog.Info("Initializing datastore connection...")
 
@@ -188,14 +205,15 @@ func run() error {
 
 		var wg sync.WaitGroup
 
-		startActiveMonitorAndLog(gCtx, &wg, activeMonitor, eventChan)
+		pollStartupJitter := time.Duration(cfg.PollStartupJitterSeconds) * time.Second
+		startActiveMonitorAndLog(gCtx, &wg, activeMonitor, pollStartupJitter, eventChan)
 
 		wg.Add(1)
 
 		go func() {
 			defer wg.Done()
 
-			runEventProcessorLoop(gCtx, eventChan, eventProcessor)
+			runEventProcessorLoop(gCtx, eventChan, eventProcessor, streamServer)
 			slog.Info("Event processing loop stopped.")
 		}()
 
@@ -265,11 +283,13 @@ func initActiveMonitor(
 }
 
 // runEventProcessorLoop consumes normalized events from eventChan and hands
-// them to the datastore-backed Processor until the context is cancelled.
+// them to the datastore-backed Processor until the context is cancelled. When
+// streamServer is set, each event is also published to its subscribers.
 func runEventProcessorLoop(
 	ctx context.Context,
 	eventChan <-chan model.MaintenanceEvent,
 	processor *eventpkg.Processor,
+	streamServer *eventstream.Server,
 ) {
 	slog.Info("Starting event processing worker loop (main monitor)...")
 
@@ -310,6 +330,10 @@ func runEventProcessorLoop(
 					"eventID", receivedEvent.EventID,
 				)
 			}
+
+			if streamServer != nil {
+				streamServer.Publish(&receivedEvent)
+			}
 		}
 	}
 }
@@ -27,6 +27,7 @@ const (
 	DefaultTriggerQuarantineWorkflowTimeLimitMinutes = 30
 	DefaultPostMaintenanceHealthyDelayMinutes        = 15
 	DefaultNodeReadinessTimeoutMinutes               = 60
+	DefaultEventStreamPort                           = 50051
 
 	MinMaintenanceEventPollIntervalSeconds       = 10
 	MinTriggerQuarantineWorkflowTimeLimitMinutes = 1
@@ -41,9 +42,21 @@ type Config struct {
 	TriggerQuarantineWorkflowTimeLimitMinutes int       `toml:"triggerQuarantineWorkflowTimeLimitMinutes"`
 	PostMaintenanceHealthyDelayMinutes        int       `toml:"postMaintenanceHealthyDelayMinutes"`
 	NodeReadinessTimeoutMinutes               int       `toml:"nodeReadinessTimeoutMinutes"`
+	EventDeduplicationWindowSeconds           int       `toml:"eventDeduplicationWindowSeconds"`
+	PollStartupJitterSeconds                  int       `toml:"pollStartupJitterSeconds"`
+	AnnotatePodsWithMaintenanceWindow         bool      `toml:"annotatePodsWithMaintenanceWindow"`
 	ClusterName                               string    `toml:"clusterName"`
 	GCP                                       GCPConfig `toml:"gcp"`
 	AWS                                       AWSConfig `toml:"aws"`
+
+	EventStream EventStreamConfig `toml:"eventStream"`
+}
+
+// EventStreamConfig configures the gRPC server that streams maintenance events
+// to external consumers.
+type EventStreamConfig struct {
+	Enabled bool `toml:"enabled"`
+	Port    int  `toml:"port"`
 }
 
 // GCPConfig holds GCP specific configuration.
@@ -128,6 +141,14 @@ func applyDefaults(cfg *Config) {
 
 		cfg.NodeReadinessTimeoutMinutes = DefaultNodeReadinessTimeoutMinutes
 	}
+
+	if cfg.EventStream.Enabled && cfg.EventStream.Port == 0 {
+		slog.Info("Configuration not set, applying default",
+			"setting", "eventStream.port",
+			"default", DefaultEventStreamPort)
+
+		cfg.EventStream.Port = DefaultEventStreamPort
+	}
 }
 
 // validateGeneralConfig checks and enforces settings for logging and global timeouts.
@@ -173,6 +194,27 @@ func validateGeneralConfig(cfg *Config) error {
 		)
 	}
 
+	// Validate EventDeduplicationWindowSeconds (0 disables deduplication)
+	if cfg.EventDeduplicationWindowSeconds < 0 {
+		return fmt.Errorf(
+			"eventDeduplicationWindowSeconds must not be negative (got %d)",
+			cfg.EventDeduplicationWindowSeconds,
+		)
+	}
+
+	// Validate PollStartupJitterSeconds (0 disables the startup jitter)
+	if cfg.PollStartupJitterSeconds < 0 {
+		return fmt.Errorf(
+			"pollStartupJitterSeconds must not be negative (got %d)",
+			cfg.PollStartupJitterSeconds,
+		)
+	}
+
+	// Validate EventStream port
+	if cfg.EventStream.Enabled && (cfg.EventStream.Port < 1 || cfg.EventStream.Port > 65535) {
+		return fmt.Errorf("eventStream.port must be between 1 and 65535 (got %d)", cfg.EventStream.Port)
+	}
+
 	return nil
 }
 
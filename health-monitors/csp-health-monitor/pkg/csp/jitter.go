@@ -0,0 +1,70 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csp
+
+import (
+	"context"
+	"hash/fnv"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// PollStartupDelay returns how long a monitor should wait before its first poll
+// so that replicas started together do not hit the CSP API in lockstep. The
+// delay is in [0, maxJitter). A non-empty identity (e.g. the pod name) yields a
+// stable offset for that replica; otherwise the offset is random. A maxJitter of
+// zero or less disables the delay.
+func PollStartupDelay(identity string, maxJitter time.Duration) time.Duration {
+	if maxJitter <= 0 {
+		return 0
+	}
+
+	if identity == "" {
+		return rand.N(maxJitter)
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(identity))
+
+	return time.Duration(h.Sum64() % uint64(maxJitter))
+}
+
+// StartMonitoringWithDelay waits for delay and then calls m.StartMonitoring. It
+// returns the context error without starting the monitor if ctx is cancelled
+// while waiting.
+func StartMonitoringWithDelay(
+	ctx context.Context,
+	m Monitor,
+	delay time.Duration,
+	eventChan chan<- model.MaintenanceEvent,
+) error {
+	if delay > 0 {
+		slog.Info("Delaying first CSP poll", "name", m.GetName(), "delay", delay)
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return m.StartMonitoring(ctx, eventChan)
+}
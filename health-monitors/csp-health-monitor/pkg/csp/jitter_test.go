@@ -0,0 +1,82 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csp
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+type startRecordingMonitor struct {
+	startedAt time.Time
+}
+
+func (m *startRecordingMonitor) StartMonitoring(_ context.Context, _ chan<- model.MaintenanceEvent) error {
+	m.startedAt = time.Now()
+	return nil
+}
+
+func (m *startRecordingMonitor) GetName() model.CSP {
+	return model.CSPGCP
+}
+
+func TestPollStartupDelay(t *testing.T) {
+	maxJitter := 30 * time.Second
+
+	assert.Zero(t, PollStartupDelay("csp-health-monitor-abc", 0), "zero jitter disables the delay")
+
+	for _, identity := range []string{"", "csp-health-monitor-abc", "csp-health-monitor-def"} {
+		for range 20 {
+			delay := PollStartupDelay(identity, maxJitter)
+			assert.GreaterOrEqual(t, delay, time.Duration(0))
+			assert.Less(t, delay, maxJitter)
+		}
+	}
+
+	assert.Equal(t, PollStartupDelay("csp-health-monitor-abc", maxJitter),
+		PollStartupDelay("csp-health-monitor-abc", maxJitter), "a replica's offset must be stable")
+}
+
+func TestStartMonitoringWithDelay(t *testing.T) {
+	t.Run("initial poll waits for the jitter delay", func(t *testing.T) {
+		maxJitter := 400 * time.Millisecond
+		delay := PollStartupDelay("csp-health-monitor-abc", maxJitter)
+		monitor := &startRecordingMonitor{}
+
+		begin := time.Now()
+		require.NoError(t, StartMonitoringWithDelay(t.Context(), monitor, delay, nil))
+
+		elapsed := monitor.startedAt.Sub(begin)
+		assert.GreaterOrEqual(t, elapsed, delay)
+		assert.Less(t, elapsed, maxJitter+time.Second)
+	})
+
+	t.Run("cancellation during the delay skips monitoring", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+
+		monitor := &startRecordingMonitor{}
+
+		err := StartMonitoringWithDelay(ctx, monitor, time.Minute, nil)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.True(t, monitor.startedAt.IsZero())
+	})
+}
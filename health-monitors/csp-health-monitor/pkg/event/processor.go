@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"time"
 
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/datastore"
@@ -32,6 +33,18 @@ type Processor struct {
 	store  datastore.Store
 	config *config.Config
 	mu     sync.Mutex
+
+	// dedupWindow is how long an identical event is suppressed after it was
+	// last persisted. Zero disables deduplication.
+	dedupWindow time.Duration
+	seen        map[string]dedupEntry
+	now         func() time.Time
+}
+
+// dedupEntry records the last persisted state of an event, keyed by EventID.
+type dedupEntry struct {
+	fingerprint string
+	persistedAt time.Time
 }
 
 // NewProcessor returns an initialized Processor. k8sMapper parameter is
@@ -42,8 +55,11 @@ func NewProcessor(cfg *config.Config, store datastore.Store) (*Processor, error)
 	}
 
 	return &Processor{
-		config: cfg,
-		store:  store,
+		config:      cfg,
+		store:       store,
+		dedupWindow: time.Duration(cfg.EventDeduplicationWindowSeconds) * time.Second,
+		seen:        make(map[string]dedupEntry),
+		now:         time.Now,
 	}, nil
 }
 
@@ -270,6 +286,17 @@ func (p *Processor) ProcessEvent(ctx context.Context, event *model.MaintenanceEv
 
 	p.ensureClusterName(event)
 	defaultStatus(event)
+
+	fingerprint := eventFingerprint(event)
+	if p.isDuplicate(event.EventID, fingerprint) {
+		metrics.MainEventsDeduplicated.WithLabelValues(string(event.CSP)).Inc()
+		slog.Debug("Skipping duplicate event within deduplication window",
+			"eventID", event.EventID,
+			"window", p.dedupWindow)
+
+		return nil
+	}
+
 	p.inheritState(ctx, event)
 	p.logEventDetails(event)
 	p.logMissingNode(event)
@@ -287,6 +314,7 @@ func (p *Processor) ProcessEvent(ctx context.Context, event *model.MaintenanceEv
 	}
 
 	metrics.MainDatastoreUpsert.WithLabelValues(string(event.CSP), metrics.StatusSuccess).Inc()
+	p.recordPersisted(event.EventID, fingerprint)
 
 	slog.Debug("Processed event",
 		"eventID", event.EventID,
@@ -294,3 +322,52 @@ func (p *Processor) ProcessEvent(ctx context.Context, event *model.MaintenanceEv
 
 	return nil
 }
+
+// eventFingerprint captures the CSP-reported fields of an event as received,
+// before any state inheritance. Two events with the same EventID and
+// fingerprint describe the same maintenance in the same state.
+func eventFingerprint(event *model.MaintenanceEvent) string {
+	return fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s|%s",
+		event.Status,
+		event.CSPStatus,
+		event.NodeName,
+		event.MaintenanceType,
+		safeFormatTime(event.ScheduledStartTime),
+		safeFormatTime(event.ScheduledEndTime),
+		safeFormatTime(event.ActualStartTime),
+		safeFormatTime(event.ActualEndTime))
+}
+
+// isDuplicate reports whether an event with the same EventID and fingerprint
+// was persisted within the deduplication window. Events whose fingerprint
+// changed are never considered duplicates so that updates are not lost.
+func (p *Processor) isDuplicate(eventID, fingerprint string) bool {
+	if p.dedupWindow <= 0 || eventID == "" {
+		return false
+	}
+
+	entry, ok := p.seen[eventID]
+	if !ok || entry.fingerprint != fingerprint {
+		return false
+	}
+
+	return p.now().Sub(entry.persistedAt) < p.dedupWindow
+}
+
+// recordPersisted remembers the fingerprint of a persisted event and evicts
+// entries that have fallen out of the deduplication window.
+func (p *Processor) recordPersisted(eventID, fingerprint string) {
+	if p.dedupWindow <= 0 || eventID == "" {
+		return
+	}
+
+	now := p.now()
+
+	for id, entry := range p.seen {
+		if now.Sub(entry.persistedAt) >= p.dedupWindow {
+			delete(p.seen, id)
+		}
+	}
+
+	p.seen[eventID] = dedupEntry{fingerprint: fingerprint, persistedAt: now}
+}
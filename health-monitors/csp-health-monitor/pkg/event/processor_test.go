@@ -0,0 +1,126 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package event
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/datastore"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// upsertRecordingStore records upserted events; other Store methods are unused
+// for events in the DETECTED state.
+type upsertRecordingStore struct {
+	datastore.Store
+	upserts []model.MaintenanceEvent
+}
+
+func (s *upsertRecordingStore) UpsertMaintenanceEvent(_ context.Context, event *model.MaintenanceEvent) error {
+	s.upserts = append(s.upserts, *event)
+	return nil
+}
+
+func newDedupTestProcessor(t *testing.T, windowSeconds int) (*Processor, *upsertRecordingStore, *time.Time) {
+	t.Helper()
+
+	store := &upsertRecordingStore{}
+	cfg := &config.Config{ClusterName: "test-cluster", EventDeduplicationWindowSeconds: windowSeconds}
+
+	p, err := NewProcessor(cfg, store)
+	require.NoError(t, err)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	p.now = func() time.Time { return now }
+
+	return p, store, &now
+}
+
+func newDedupTestEvent(cspStatus model.ProviderStatus) *model.MaintenanceEvent {
+	start := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	return &model.MaintenanceEvent{
+		EventID:            "event-1",
+		CSP:                model.CSPGCP,
+		NodeName:           "node-1",
+		Status:             model.StatusDetected,
+		CSPStatus:          cspStatus,
+		ScheduledStartTime: &start,
+	}
+}
+
+func TestProcessEventDeduplication(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("identical event within window is skipped", func(t *testing.T) {
+		p, store, now := newDedupTestProcessor(t, 300)
+
+		require.NoError(t, p.ProcessEvent(ctx, newDedupTestEvent(model.CSPStatusPending)))
+		*now = now.Add(time.Minute)
+		require.NoError(t, p.ProcessEvent(ctx, newDedupTestEvent(model.CSPStatusPending)))
+
+		assert.Len(t, store.upserts, 1)
+	})
+
+	t.Run("identical event outside window is persisted again", func(t *testing.T) {
+		p, store, now := newDedupTestProcessor(t, 300)
+
+		require.NoError(t, p.ProcessEvent(ctx, newDedupTestEvent(model.CSPStatusPending)))
+		*now = now.Add(5 * time.Minute)
+		require.NoError(t, p.ProcessEvent(ctx, newDedupTestEvent(model.CSPStatusPending)))
+
+		assert.Len(t, store.upserts, 2)
+	})
+
+	t.Run("changed event within window is persisted as an update", func(t *testing.T) {
+		p, store, now := newDedupTestProcessor(t, 300)
+
+		require.NoError(t, p.ProcessEvent(ctx, newDedupTestEvent(model.CSPStatusPending)))
+		*now = now.Add(time.Minute)
+		require.NoError(t, p.ProcessEvent(ctx, newDedupTestEvent(model.CSPStatusOngoing)))
+		*now = now.Add(time.Minute)
+		require.NoError(t, p.ProcessEvent(ctx, newDedupTestEvent(model.CSPStatusOngoing)))
+
+		require.Len(t, store.upserts, 2)
+		assert.Equal(t, model.CSPStatusOngoing, store.upserts[1].CSPStatus)
+	})
+
+	t.Run("different event IDs are not collapsed", func(t *testing.T) {
+		p, store, _ := newDedupTestProcessor(t, 300)
+
+		other := newDedupTestEvent(model.CSPStatusPending)
+		other.EventID = "event-2"
+
+		require.NoError(t, p.ProcessEvent(ctx, newDedupTestEvent(model.CSPStatusPending)))
+		require.NoError(t, p.ProcessEvent(ctx, other))
+
+		assert.Len(t, store.upserts, 2)
+	})
+
+	t.Run("zero window disables deduplication", func(t *testing.T) {
+		p, store, _ := newDedupTestProcessor(t, 0)
+
+		require.NoError(t, p.ProcessEvent(ctx, newDedupTestEvent(model.CSPStatusPending)))
+		require.NoError(t, p.ProcessEvent(ctx, newDedupTestEvent(model.CSPStatusPending)))
+
+		assert.Len(t, store.upserts, 2)
+		assert.Empty(t, p.seen)
+	})
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eventstream exposes the maintenance events emitted by the CSP monitor
+// to external consumers over a gRPC server stream.
+package eventstream
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/maintenance/v1alpha1"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// subscriberBufferSize bounds the events queued for a single subscriber. Events
+// for a subscriber whose queue is full are dropped rather than blocking the monitor.
+const subscriberBufferSize = 100
+
+// Server fans out published maintenance events to every connected
+// WatchMaintenanceEvents stream.
+type Server struct {
+	v1alpha1.UnimplementedMaintenanceEventServiceServer
+
+	mu          sync.Mutex
+	subscribers map[chan *v1alpha1.MaintenanceEvent]struct{}
+}
+
+// NewServer returns a Server with no subscribers.
+func NewServer() *Server {
+	return &Server{
+		subscribers: make(map[chan *v1alpha1.MaintenanceEvent]struct{}),
+	}
+}
+
+// Publish sends event to all current subscribers without blocking.
+func (s *Server) Publish(event *model.MaintenanceEvent) {
+	if event == nil {
+		return
+	}
+
+	msg := toProto(event)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ch := range s.subscribers {
+		select {
+		case ch <- msg:
+		default:
+			metrics.EventStreamDropped.WithLabelValues(string(event.CSP)).Inc()
+			slog.Warn("Event stream subscriber is not keeping up; dropping event",
+				"eventID", event.EventID)
+		}
+	}
+}
+
+// WatchMaintenanceEvents streams every event published after the call until the
+// client disconnects or the server shuts down.
+func (s *Server) WatchMaintenanceEvents(
+	_ *v1alpha1.WatchMaintenanceEventsRequest,
+	stream grpc.ServerStreamingServer[v1alpha1.WatchMaintenanceEventsResponse],
+) error {
+	ch := s.subscribe()
+	defer s.unsubscribe(ch)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case msg := <-ch:
+			if err := stream.Send(&v1alpha1.WatchMaintenanceEventsResponse{Event: msg}); err != nil {
+				return fmt.Errorf("failed to send maintenance event: %w", err)
+			}
+		}
+	}
+}
+
+func (s *Server) subscribe() chan *v1alpha1.MaintenanceEvent {
+	ch := make(chan *v1alpha1.MaintenanceEvent, subscriberBufferSize)
+
+	s.mu.Lock()
+	s.subscribers[ch] = struct{}{}
+	s.mu.Unlock()
+
+	metrics.EventStreamSubscribers.Inc()
+
+	return ch
+}
+
+func (s *Server) unsubscribe(ch chan *v1alpha1.MaintenanceEvent) {
+	s.mu.Lock()
+	delete(s.subscribers, ch)
+	s.mu.Unlock()
+
+	metrics.EventStreamSubscribers.Dec()
+}
+
+// Serve registers the server on a new gRPC server listening on port and blocks
+// until ctx is cancelled.
+func (s *Server) Serve(ctx context.Context, port int) error {
+	var lc net.ListenConfig
+
+	lis, err := lc.Listen(ctx, "tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to listen on event stream port %d: %w", port, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	v1alpha1.RegisterMaintenanceEventServiceServer(grpcServer, s)
+
+	go func() {
+		<-ctx.Done()
+		slog.Info("Shutting down event stream gRPC server")
+		grpcServer.GracefulStop()
+	}()
+
+	slog.Info("Starting event stream gRPC server", "port", port)
+
+	if err := grpcServer.Serve(lis); err != nil {
+		return fmt.Errorf("failed to serve event stream: %w", err)
+	}
+
+	return nil
+}
+
+func toProto(event *model.MaintenanceEvent) *v1alpha1.MaintenanceEvent {
+	return &v1alpha1.MaintenanceEvent{
+		EventId:                event.EventID,
+		Csp:                    string(event.CSP),
+		ClusterName:            event.ClusterName,
+		ResourceType:           event.ResourceType,
+		ResourceId:             event.ResourceID,
+		MaintenanceType:        string(event.MaintenanceType),
+		Status:                 string(event.Status),
+		CspStatus:              string(event.CSPStatus),
+		ScheduledStartTime:     optionalTimestamp(event.ScheduledStartTime),
+		ScheduledEndTime:       optionalTimestamp(event.ScheduledEndTime),
+		ActualStartTime:        optionalTimestamp(event.ActualStartTime),
+		ActualEndTime:          optionalTimestamp(event.ActualEndTime),
+		EventReceivedTimestamp: timestamppb.New(event.EventReceivedTimestamp),
+		LastUpdatedTimestamp:   timestamppb.New(event.LastUpdatedTimestamp),
+		RecommendedAction:      event.RecommendedAction,
+		Metadata:               maps.Clone(event.Metadata),
+		NodeName:               event.NodeName,
+	}
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventstream
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	v1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/maintenance/v1alpha1"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+func (s *Server) subscriberCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.subscribers)
+}
+
+func startTestServer(t *testing.T) (*Server, v1alpha1.MaintenanceEventServiceClient) {
+	t.Helper()
+
+	srv := NewServer()
+	lis := bufconn.Listen(1 << 20)
+
+	grpcServer := grpc.NewServer()
+	v1alpha1.RegisterMaintenanceEventServiceServer(grpcServer, srv)
+
+	go func() { _ = grpcServer.Serve(lis) }()
+
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return srv, v1alpha1.NewMaintenanceEventServiceClient(conn)
+}
+
+func TestWatchMaintenanceEventsReceivesPublishedEvents(t *testing.T) {
+	srv, client := startTestServer(t)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchMaintenanceEvents(ctx, &v1alpha1.WatchMaintenanceEventsRequest{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return srv.subscriberCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	srv.Publish(&model.MaintenanceEvent{
+		EventID:            "event-1",
+		CSP:                model.CSPGCP,
+		ClusterName:        "cluster-a",
+		ResourceID:         "instance-1",
+		MaintenanceType:    model.TypeScheduled,
+		Status:             model.StatusDetected,
+		CSPStatus:          model.CSPStatusPending,
+		ScheduledStartTime: &start,
+		RecommendedAction:  "RESTART_VM",
+		Metadata:           map[string]string{"zone": "us-central1-a"},
+		NodeName:           "node-1",
+	})
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+
+	event := resp.GetEvent()
+	assert.Equal(t, "event-1", event.GetEventId())
+	assert.Equal(t, "gcp", event.GetCsp())
+	assert.Equal(t, "SCHEDULED", event.GetMaintenanceType())
+	assert.Equal(t, "DETECTED", event.GetStatus())
+	assert.Equal(t, "PENDING", event.GetCspStatus())
+	assert.Equal(t, start, event.GetScheduledStartTime().AsTime())
+	assert.Nil(t, event.GetActualStartTime())
+	assert.Equal(t, "us-central1-a", event.GetMetadata()["zone"])
+	assert.Equal(t, "node-1", event.GetNodeName())
+}
+
+func TestWatchMaintenanceEventsUnsubscribesOnDisconnect(t *testing.T) {
+	srv, client := startTestServer(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	_, err := client.WatchMaintenanceEvents(ctx, &v1alpha1.WatchMaintenanceEventsRequest{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return srv.subscriberCount() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+
+	require.Eventually(t, func() bool { return srv.subscriberCount() == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	// Publishing with no subscribers must not block.
+	srv.Publish(&model.MaintenanceEvent{EventID: "event-2", CSP: model.CSPAWS})
+}
@@ -140,6 +140,28 @@ var (
 		},
 		[]string{"csp", "status"}, // gcp/aws, success/failed
 	)
+	MainEventsDeduplicated = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "csp_health_monitor_main_events_deduplicated_total",
+			Help: "Total number of maintenance events skipped as duplicates within the deduplication window.",
+		},
+		[]string{"csp"}, // gcp, aws
+	)
+
+	// Event Stream Metrics
+	EventStreamSubscribers = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "csp_health_monitor_event_stream_subscribers",
+			Help: "Number of clients currently subscribed to the maintenance event stream.",
+		},
+	)
+	EventStreamDropped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "csp_health_monitor_event_stream_dropped_total",
+			Help: "Total number of maintenance events dropped for event stream subscribers that were not keeping up.",
+		},
+		[]string{"csp"}, // gcp, aws
+	)
 )
 
 // --- Quarantine Trigger Engine (Sidecar) Metrics ---
@@ -212,6 +234,27 @@ var (
 		[]string{"trigger_type", "failure_reason"}, // quarantine/healthy, mapping/uds/db_update
 	)
 
+	// MaintenanceLeadTime measures how far ahead of the scheduled maintenance window
+	// remediation began. Negative values mean remediation started after the window opened.
+	MaintenanceLeadTime = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "csp_maintenance_lead_seconds",
+			Help: "Time between the quarantine trigger and the scheduled start of the maintenance window.",
+			Buckets: []float64{
+				-3600, -600, -60, 0, 60, 300, 600, 900, 1800, 3600, 7200, 14400, 43200, 86400,
+			},
+		},
+		[]string{"csp"},
+	)
+
+	TriggerPodAnnotationErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "csp_health_monitor_trigger_pod_annotation_errors_total",
+			Help: "Total number of failures setting or clearing maintenance window annotations on pods.",
+		},
+		[]string{"operation"}, // set, clear
+	)
+
 	// UDS Metrics
 	TriggerUDSSendDuration = promauto.NewHistogram(
 		prometheus.HistogramOpts{
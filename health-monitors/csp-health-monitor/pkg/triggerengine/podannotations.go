@@ -0,0 +1,185 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
+)
+
+// Annotations set on every pod of a node with scheduled CSP maintenance, so that
+// workload-side controllers can checkpoint or migrate before the window opens.
+// Times are RFC 3339 in UTC. A bound the CSP did not report is left unset.
+const (
+	MaintenanceWindowStartAnnotation = "nvsentinel.nvidia.com/maintenance-window-start"
+	MaintenanceWindowEndAnnotation   = "nvsentinel.nvidia.com/maintenance-window-end"
+	MaintenanceEventIDAnnotation     = "nvsentinel.nvidia.com/maintenance-event-id"
+
+	annotationOperationSet   = "set"
+	annotationOperationClear = "clear"
+)
+
+// annotatePodsWithMaintenanceWindow records the scheduled window of event on every pod
+// running on the event's node. Pods already annotated for the same event are skipped.
+func (e *Engine) annotatePodsWithMaintenanceWindow(ctx context.Context, event model.MaintenanceEvent) error {
+	annotations := map[string]*string{
+		MaintenanceEventIDAnnotation:     &event.EventID,
+		MaintenanceWindowStartAnnotation: formatWindowBound(event.ScheduledStartTime),
+		MaintenanceWindowEndAnnotation:   formatWindowBound(event.ScheduledEndTime),
+	}
+
+	patched, err := e.patchNodePods(ctx, event.NodeName, annotations, func(podAnnotations map[string]string) bool {
+		return podAnnotations[MaintenanceEventIDAnnotation] != event.EventID
+	})
+	if err != nil {
+		metrics.TriggerPodAnnotationErrors.WithLabelValues(annotationOperationSet).Inc()
+		return err
+	}
+
+	e.annotatedEvents.Store(event.NodeName, event)
+
+	slog.Info("Annotated pods with maintenance window",
+		"node", event.NodeName,
+		"eventID", event.EventID,
+		"pods", patched)
+
+	return nil
+}
+
+// clearMaintenanceWindowAnnotations removes the maintenance window annotations written
+// for event from the pods on its node. Pods annotated for a different event are left as is.
+func (e *Engine) clearMaintenanceWindowAnnotations(ctx context.Context, event model.MaintenanceEvent) error {
+	annotations := map[string]*string{
+		MaintenanceEventIDAnnotation:     nil,
+		MaintenanceWindowStartAnnotation: nil,
+		MaintenanceWindowEndAnnotation:   nil,
+	}
+
+	patched, err := e.patchNodePods(ctx, event.NodeName, annotations, func(podAnnotations map[string]string) bool {
+		return podAnnotations[MaintenanceEventIDAnnotation] == event.EventID
+	})
+	if err != nil {
+		metrics.TriggerPodAnnotationErrors.WithLabelValues(annotationOperationClear).Inc()
+		return err
+	}
+
+	e.annotatedEvents.Delete(event.NodeName)
+
+	slog.Info("Cleared maintenance window annotations from pods",
+		"node", event.NodeName,
+		"eventID", event.EventID,
+		"pods", patched)
+
+	return nil
+}
+
+// clearCancelledMaintenanceWindows clears the annotations of events that were cancelled
+// by the CSP after their pods were annotated. Only events annotated by this process are
+// tracked, so annotations of an event cancelled while the engine was restarting remain
+// until the node's next maintenance completes.
+func (e *Engine) clearCancelledMaintenanceWindows(ctx context.Context) {
+	e.annotatedEvents.Range(func(key, value any) bool {
+		event, ok := value.(model.MaintenanceEvent)
+		if !ok || event.MaintenanceType == "" {
+			return true
+		}
+
+		cancelled, found, err := e.store.FindLatestActiveEventByNodeAndType(
+			ctx, event.NodeName, event.MaintenanceType, []model.InternalStatus{model.StatusCancelled})
+		if err != nil {
+			slog.Error("Failed to check maintenance event for cancellation",
+				"eventID", event.EventID,
+				"node", event.NodeName,
+				"error", err)
+
+			return true
+		}
+
+		if !found || cancelled.EventID != event.EventID {
+			return true
+		}
+
+		if err := e.clearMaintenanceWindowAnnotations(ctx, event); err != nil {
+			slog.Error("Failed to clear maintenance window annotations for cancelled event",
+				"eventID", event.EventID,
+				"node", event.NodeName,
+				"error", err)
+		}
+
+		return true
+	})
+}
+
+// patchNodePods merge-patches annotations onto every pod on nodeName accepted by selectPod.
+// A nil annotation value removes the key. It returns the number of pods patched.
+func (e *Engine) patchNodePods(
+	ctx context.Context,
+	nodeName string,
+	annotations map[string]*string,
+	selectPod func(podAnnotations map[string]string) bool,
+) (int, error) {
+	pods, err := e.k8sClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("spec.nodeName=%s", nodeName),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pods on node %s: %w", nodeName, err)
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{"annotations": annotations},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to build annotation patch: %w", err)
+	}
+
+	patched := 0
+
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName != nodeName || !selectPod(pod.Annotations) {
+			continue
+		}
+
+		_, err := e.k8sClient.CoreV1().Pods(pod.Namespace).Patch(
+			ctx, pod.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return patched, fmt.Errorf("failed to patch pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+
+		patched++
+	}
+
+	return patched, nil
+}
+
+// formatWindowBound renders a window bound for an annotation value. A nil bound yields a
+// nil value, which leaves the annotation unset.
+func formatWindowBound(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+
+	formatted := t.UTC().Format(time.RFC3339)
+
+	return &formatted
+}
@@ -62,6 +62,7 @@ type Engine struct {
 	pollInterval       time.Duration
 	k8sClient          kubernetes.Interface
 	monitoredNodes     sync.Map // Track which nodes are currently being monitored
+	annotatedEvents    sync.Map // Node name -> event whose window is annotated on the node's pods
 	monitorInterval    time.Duration
 	processingStrategy pb.ProcessingStrategy
 }
@@ -135,6 +136,10 @@ func (e *Engine) checkAndTriggerEvents(ctx context.Context) error {
 		) // Return error to increment poll error metric
 	}
 
+	if e.config.AnnotatePodsWithMaintenanceWindow {
+		e.clearCancelledMaintenanceWindows(ctx)
+	}
+
 	metrics.TriggerEventsFound.WithLabelValues(quarantineTriggerType).Add(float64(len(quarantineEvents)))
 	slog.Debug("Found events potentially needing quarantine trigger",
 		"count", len(quarantineEvents))
@@ -283,6 +288,15 @@ func (e *Engine) processAndSendTrigger(
 	}
 
 	metrics.TriggerSuccess.WithLabelValues(triggerType).Inc()
+
+	if triggerType == quarantineTriggerType {
+		observeMaintenanceLeadTime(event, time.Now())
+	}
+
+	if e.config.AnnotatePodsWithMaintenanceWindow {
+		e.updatePodMaintenanceWindow(ctx, event, triggerType)
+	}
+
 	slog.Info("Successfully triggered event and updated status",
 		"type", strings.ToUpper(triggerType),
 		"node", event.NodeName,
@@ -291,6 +305,47 @@ func (e *Engine) processAndSendTrigger(
 	return nil
 }
 
+// updatePodMaintenanceWindow annotates the node's pods with the maintenance window when
+// quarantine is triggered and clears the annotations once the node is healthy again.
+// Failures are logged only; the health event has already been sent.
+func (e *Engine) updatePodMaintenanceWindow(ctx context.Context, event model.MaintenanceEvent, triggerType string) {
+	var err error
+
+	switch triggerType {
+	case quarantineTriggerType:
+		err = e.annotatePodsWithMaintenanceWindow(ctx, event)
+	case healthyTriggerType:
+		err = e.clearMaintenanceWindowAnnotations(ctx, event)
+	default:
+		return
+	}
+
+	if err != nil {
+		slog.Error("Failed to update maintenance window annotations on pods",
+			"triggerType", triggerType,
+			"eventID", event.EventID,
+			"node", event.NodeName,
+			"error", err)
+	}
+}
+
+// observeMaintenanceLeadTime records how far ahead of the scheduled maintenance window the
+// quarantine was triggered. Events without a scheduled start time are not observed.
+func observeMaintenanceLeadTime(event model.MaintenanceEvent, triggeredAt time.Time) {
+	if event.ScheduledStartTime == nil {
+		return
+	}
+
+	lead := event.ScheduledStartTime.Sub(triggeredAt)
+	metrics.MaintenanceLeadTime.WithLabelValues(string(event.CSP)).Observe(lead.Seconds())
+
+	slog.Debug("Observed maintenance lead time",
+		"eventID", event.EventID,
+		"node", event.NodeName,
+		"scheduledStart", event.ScheduledStartTime.Format(time.RFC3339),
+		"leadSeconds", lead.Seconds())
+}
+
 // triggerQuarantine constructs and sends an unhealthy (fatal=true) event via UDS.
 func (e *Engine) triggerQuarantine(ctx context.Context, event model.MaintenanceEvent) error {
 	return e.processAndSendTrigger(
@@ -21,6 +21,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
@@ -34,6 +36,7 @@ import (
 
 	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/config"
+	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/metrics"
 	"github.com/nvidia/nvsentinel/health-monitors/csp-health-monitor/pkg/model"
 )
 
@@ -642,6 +645,58 @@ func TestProcessAndSendTrigger(t *testing.T) {
 	}
 }
 
+func TestQuarantineTriggerObservesMaintenanceLeadTime(t *testing.T) {
+	ctx := context.Background()
+
+	histogramSnapshot := func(csp model.CSP) (uint64, float64) {
+		m := &dto.Metric{}
+		hist := metrics.MaintenanceLeadTime.WithLabelValues(string(csp)).(prometheus.Histogram)
+		assert.NoError(t, hist.Write(m))
+
+		return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+	}
+
+	scheduledStart := time.Now().Add(30 * time.Minute)
+	event := model.MaintenanceEvent{
+		EventID:            "event-lead-time",
+		CSP:                model.CSPAWS,
+		NodeName:           "node-1",
+		ResourceType:       "EC2",
+		ResourceID:         "i-0123456789abcdef0",
+		ScheduledStartTime: &scheduledStart,
+		RecommendedAction:  pb.RecommendedAction_RESTART_VM.String(),
+	}
+
+	mStore := new(MockDatastore)
+	mUDSClient := new(MockUDSClient)
+	mUDSClient.On("HealthEventOccurredV1", ctx, mock.Anything, mock.Anything).Return(&emptypb.Empty{}, nil).Once()
+	mStore.On("UpdateEventStatus", ctx, event.EventID, model.StatusQuarantineTriggered).Return(nil).Once()
+
+	engine := NewEngine(newTestConfig(), mStore, mUDSClient, nil, pb.ProcessingStrategy_EXECUTE_REMEDIATION)
+
+	countBefore, sumBefore := histogramSnapshot(model.CSPAWS)
+
+	assert.NoError(t, engine.triggerQuarantine(ctx, event))
+
+	countAfter, sumAfter := histogramSnapshot(model.CSPAWS)
+	assert.Equal(t, countBefore+1, countAfter)
+	assert.InDelta(t, (30 * time.Minute).Seconds(), sumAfter-sumBefore, 5)
+
+	// Triggers for events without a scheduled window are not observed.
+	event.EventID = "event-no-schedule"
+	event.ScheduledStartTime = nil
+	mUDSClient.On("HealthEventOccurredV1", ctx, mock.Anything, mock.Anything).Return(&emptypb.Empty{}, nil).Once()
+	mStore.On("UpdateEventStatus", ctx, event.EventID, model.StatusQuarantineTriggered).Return(nil).Once()
+
+	assert.NoError(t, engine.triggerQuarantine(ctx, event))
+
+	countAfterUnscheduled, _ := histogramSnapshot(model.CSPAWS)
+	assert.Equal(t, countAfter, countAfterUnscheduled)
+
+	mStore.AssertExpectations(t)
+	mUDSClient.AssertExpectations(t)
+}
+
 func TestCheckAndTriggerEvents(t *testing.T) {
 	ctx := context.Background()
 	cfg := newTestConfig()
@@ -862,3 +917,127 @@ func TestHealthyTriggerWaitsForNodeReady(t *testing.T) {
 	mUDSClient.AssertExpectations(t)
 	mStore.AssertExpectations(t)
 }
+
+func newPodOnNode(namespace, name, nodeName string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+	}
+}
+
+func TestMaintenanceWindowPodAnnotations(t *testing.T) {
+	ctx := context.Background()
+
+	start := time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	event := model.MaintenanceEvent{
+		EventID:            "event-window",
+		NodeName:           "node-1",
+		ResourceType:       "EC2",
+		ResourceID:         "i-0123456789abcdef0",
+		MaintenanceType:    model.TypeScheduled,
+		ScheduledStartTime: &start,
+		ScheduledEndTime:   &end,
+		RecommendedAction:  pb.RecommendedAction_RESTART_VM.String(),
+	}
+
+	newEngine := func(t *testing.T) (*Engine, *MockDatastore, *k8sfake.Clientset) {
+		t.Helper()
+
+		k8sClient := k8sfake.NewSimpleClientset(
+			newPodOnNode("team-a", "trainer-0", "node-1"),
+			newPodOnNode("team-b", "trainer-1", "node-1"),
+			newPodOnNode("team-a", "other-node", "node-2"),
+		)
+
+		mStore := new(MockDatastore)
+		mUDSClient := new(MockUDSClient)
+		mUDSClient.On("HealthEventOccurredV1", ctx, mock.Anything, mock.Anything).Return(&emptypb.Empty{}, nil)
+		mStore.On("UpdateEventStatus", ctx, event.EventID, mock.Anything).Return(nil)
+
+		cfg := newTestConfig()
+		cfg.AnnotatePodsWithMaintenanceWindow = true
+
+		return NewEngine(cfg, mStore, mUDSClient, k8sClient, pb.ProcessingStrategy_EXECUTE_REMEDIATION), mStore, k8sClient
+	}
+
+	podAnnotations := func(t *testing.T, k8sClient *k8sfake.Clientset, namespace, name string) map[string]string {
+		t.Helper()
+
+		pod, err := k8sClient.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		assert.NoError(t, err)
+
+		return pod.Annotations
+	}
+
+	assertWindowAnnotated := func(t *testing.T, k8sClient *k8sfake.Clientset) {
+		t.Helper()
+
+		for _, pod := range []struct{ namespace, name string }{{"team-a", "trainer-0"}, {"team-b", "trainer-1"}} {
+			annotations := podAnnotations(t, k8sClient, pod.namespace, pod.name)
+			assert.Equal(t, "event-window", annotations[MaintenanceEventIDAnnotation])
+			assert.Equal(t, "2025-06-01T10:00:00Z", annotations[MaintenanceWindowStartAnnotation])
+			assert.Equal(t, "2025-06-01T12:00:00Z", annotations[MaintenanceWindowEndAnnotation])
+		}
+
+		assert.Empty(t, podAnnotations(t, k8sClient, "team-a", "other-node"), "pods on other nodes are not annotated")
+	}
+
+	assertWindowCleared := func(t *testing.T, k8sClient *k8sfake.Clientset) {
+		t.Helper()
+
+		for _, pod := range []struct{ namespace, name string }{{"team-a", "trainer-0"}, {"team-b", "trainer-1"}} {
+			annotations := podAnnotations(t, k8sClient, pod.namespace, pod.name)
+			assert.NotContains(t, annotations, MaintenanceEventIDAnnotation)
+			assert.NotContains(t, annotations, MaintenanceWindowStartAnnotation)
+			assert.NotContains(t, annotations, MaintenanceWindowEndAnnotation)
+		}
+	}
+
+	t.Run("cleared after maintenance completes", func(t *testing.T) {
+		engine, _, k8sClient := newEngine(t)
+
+		assert.NoError(t, engine.triggerQuarantine(ctx, event))
+		assertWindowAnnotated(t, k8sClient)
+
+		assert.NoError(t, engine.triggerHealthy(ctx, event))
+		assertWindowCleared(t, k8sClient)
+	})
+
+	t.Run("cleared after maintenance is cancelled", func(t *testing.T) {
+		engine, mStore, k8sClient := newEngine(t)
+
+		assert.NoError(t, engine.triggerQuarantine(ctx, event))
+		assertWindowAnnotated(t, k8sClient)
+
+		cancelled := event
+		cancelled.Status = model.StatusCancelled
+		mStore.On("FindLatestActiveEventByNodeAndType", ctx, event.NodeName, event.MaintenanceType,
+			[]model.InternalStatus{model.StatusCancelled}).Return(&cancelled, true, nil).Once()
+
+		engine.clearCancelledMaintenanceWindows(ctx)
+		assertWindowCleared(t, k8sClient)
+
+		_, tracked := engine.annotatedEvents.Load(event.NodeName)
+		assert.False(t, tracked)
+	})
+
+	t.Run("newer event annotations are kept", func(t *testing.T) {
+		engine, _, k8sClient := newEngine(t)
+
+		assert.NoError(t, engine.triggerQuarantine(ctx, event))
+
+		stale := event
+		stale.EventID = "event-older"
+		assert.NoError(t, engine.clearMaintenanceWindowAnnotations(ctx, stale))
+		assertWindowAnnotated(t, k8sClient)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		engine, _, k8sClient := newEngine(t)
+		engine.config.AnnotatePodsWithMaintenanceWindow = false
+
+		assert.NoError(t, engine.triggerQuarantine(ctx, event))
+		assert.Empty(t, podAnnotations(t, k8sClient, "team-a", "trainer-0"))
+	})
+}
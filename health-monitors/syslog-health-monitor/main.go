@@ -74,6 +74,9 @@ var (
 		"Path to GPU metadata JSON file.")
 	processingStrategyFlag = flag.String("processing-strategy", "EXECUTE_REMEDIATION",
 		"Event processing strategy: EXECUTE_REMEDIATION or STORE_ONLY")
+	confirmationWindows = flag.String("confirmation-windows", "",
+		"Comma separated check=window pairs (e.g. SysLogsXIDError=10m). Fatal events of a listed check are only "+
+			"sent once they recur on the same GPU within the window, and are otherwise downgraded to non-fatal.")
 )
 
 var checks []fd.CheckDefinition
@@ -209,9 +212,44 @@ func buildChecksFromFlag() ([]fd.CheckDefinition, error) {
 		return nil, fmt.Errorf("no checks defined in the config file")
 	}
 
+	windows, err := parseConfirmationWindows(*confirmationWindows)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range list {
+		list[i].ConfirmationWindow = windows[list[i].Name]
+	}
+
 	return list, nil
 }
 
+// parseConfirmationWindows parses the comma separated check=window pairs of --confirmation-windows.
+func parseConfirmationWindows(value string) (map[string]time.Duration, error) {
+	windows := make(map[string]time.Duration)
+
+	for pair := range strings.SplitSeq(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		name, window, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid confirmation window %q: expected check=window", pair)
+		}
+
+		duration, err := time.ParseDuration(strings.TrimSpace(window))
+		if err != nil || duration < 0 {
+			return nil, fmt.Errorf("invalid confirmation window for check %s: %q", strings.TrimSpace(name), window)
+		}
+
+		windows[strings.TrimSpace(name)] = duration
+	}
+
+	return windows, nil
+}
+
 func applyKataConfig(list []fd.CheckDefinition) []fd.CheckDefinition {
 	if !stringutil.IsTruthyValue(*kataEnabled) {
 		return list
@@ -14,7 +14,10 @@
 
 package patterns
 
-import "regexp"
+import (
+	"regexp"
+	"strconv"
+)
 
 // XIDPattern matches standard NVIDIA XID error messages in the format:
 // "NVRM: Xid (PCI:0000:b3:00.0): 79, pid=1234, name=process, Ch 00000001"
@@ -24,3 +27,20 @@ import "regexp"
 var XIDPattern = regexp.MustCompile(
 	`NVRM: Xid \(PCI:([0-9a-fA-F:.]+)\): (\d+)(?:, pid=(\d+))?(?:, name=([^,]+))?(?:, Ch ([0-9a-fA-F]+))?`,
 )
+
+// ExtractXidFromKernelLog extracts the XID code and PCI address from a kernel log line
+// such as "[ 1234.5] NVRM: Xid (PCI:0000:3b:00): 79, pid=1234, ...". It returns ok=false
+// when the line does not carry an XID.
+func ExtractXidFromKernelLog(line string) (xid uint64, pci string, ok bool) {
+	m := XIDPattern.FindStringSubmatch(line)
+	if len(m) < 3 {
+		return 0, "", false
+	}
+
+	xid, err := strconv.ParseUint(m[2], 10, 64)
+	if err != nil {
+		return 0, "", false
+	}
+
+	return xid, m[1], true
+}
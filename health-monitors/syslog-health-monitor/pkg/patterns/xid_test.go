@@ -0,0 +1,78 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package patterns
+
+import "testing"
+
+func TestExtractXidFromKernelLog(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		wantXid uint64
+		wantPCI string
+		wantOK  bool
+	}{
+		{
+			name:    "dmesg with timestamp",
+			line:    "[ 1234.567890] NVRM: Xid (PCI:0000:3b:00): 79, pid='<unknown>', name=<unknown>, GPU has fallen off the bus.",
+			wantXid: 79,
+			wantPCI: "0000:3b:00",
+			wantOK:  true,
+		},
+		{
+			name:    "journald kernel line with function number",
+			line:    "Jan 01 00:00:00 node-1 kernel: NVRM: Xid (PCI:0000:b3:00.0): 13, pid=1234, name=python, Ch 00000001",
+			wantXid: 13,
+			wantPCI: "0000:b3:00.0",
+			wantOK:  true,
+		},
+		{
+			name:    "dmesg human readable timestamp",
+			line:    "[Tue Jan  2 03:04:05 2024] NVRM: Xid (PCI:0001:00:00): 48, pid=4321, name=train, An uncorrectable double bit error",
+			wantXid: 48,
+			wantPCI: "0001:00:00",
+			wantOK:  true,
+		},
+		{
+			name:    "xid without pid",
+			line:    "NVRM: Xid (PCI:0000:07:00): 154, GPU recovery action changed from 0x0 (None) to 0x1 (GPU Reset Required)",
+			wantXid: 154,
+			wantPCI: "0000:07:00",
+			wantOK:  true,
+		},
+		{
+			name: "sxid line",
+			line: "nvidia-nvswitch3: SXid (PCI:0000:c8:00.0): 28002, Non-fatal, Link 33 Thermal event",
+		},
+		{
+			name: "unrelated NVRM line",
+			line: "NVRM: GPU at PCI:0000:3b:00: GPU-0f6c1a2b-1234-5678-9abc-def012345678",
+		},
+		{
+			name: "empty line",
+			line: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			xid, pci, ok := ExtractXidFromKernelLog(tt.line)
+			if ok != tt.wantOK || xid != tt.wantXid || pci != tt.wantPCI {
+				t.Errorf("ExtractXidFromKernelLog(%q) = (%d, %q, %v), want (%d, %q, %v)",
+					tt.line, xid, pci, ok, tt.wantXid, tt.wantPCI, tt.wantOK)
+			}
+		})
+	}
+}
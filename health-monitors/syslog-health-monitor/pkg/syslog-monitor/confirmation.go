@@ -0,0 +1,231 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogmonitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/health-monitors/syslog-health-monitor/pkg/types"
+)
+
+const (
+	confirmationResultConfirmed  = "confirmed"
+	confirmationResultDowngraded = "downgraded"
+)
+
+var fatalEventConfirmations = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "syslog_health_monitor_fatal_event_confirmations",
+		Help: "Total number of fatal events held for confirmation, by whether they recurred within the window",
+	},
+	[]string{"node", "check", "result"},
+)
+
+// expiringHandler is a Handler that holds events back and releases them once they expire. The held
+// events are saved in the state file with the journal cursor, since the lines they came from are not
+// read again after a restart.
+type expiringHandler interface {
+	types.Handler
+	FlushExpired() *pb.HealthEvents
+	Held() ([]heldEvent, error)
+	Restore(held []heldEvent) error
+}
+
+// heldEvent is the state file form of an event held for confirmation.
+type heldEvent struct {
+	Event     json.RawMessage `json:"event"`
+	FirstSeen time.Time       `json:"first_seen"`
+}
+
+// confirmingHandler holds back the fatal events of a check until the same error recurs on the
+// same component within window, so a one-off error does not quarantine the node. Events that are
+// not confirmed in time are released by FlushExpired as non-fatal events that recommend no action.
+type confirmingHandler struct {
+	handler   types.Handler
+	nodeName  string
+	checkName string
+	window    time.Duration
+	now       func() time.Time
+	pending   map[string]pendingConfirmation
+}
+
+type pendingConfirmation struct {
+	event     *pb.HealthEvent
+	firstSeen time.Time
+}
+
+func newConfirmingHandler(handler types.Handler, nodeName, checkName string,
+	window time.Duration) *confirmingHandler {
+	return &confirmingHandler{
+		handler:   handler,
+		nodeName:  nodeName,
+		checkName: checkName,
+		window:    window,
+		now:       time.Now,
+		pending:   make(map[string]pendingConfirmation),
+	}
+}
+
+func (h *confirmingHandler) ProcessLine(message string) (*pb.HealthEvents, error) {
+	healthEvents, err := h.handler.ProcessLine(message)
+	if err != nil || healthEvents == nil {
+		return healthEvents, err
+	}
+
+	now := h.now()
+	events := make([]*pb.HealthEvent, 0, len(healthEvents.Events))
+
+	for _, event := range healthEvents.Events {
+		if event.IsHealthy || !event.IsFatal {
+			events = append(events, event)
+			continue
+		}
+
+		key := confirmationKey(event)
+
+		if held, ok := h.pending[key]; ok {
+			delete(h.pending, key)
+
+			if now.Sub(held.firstSeen) <= h.window {
+				slog.Info("Fatal event confirmed by a recurrence",
+					"check", h.checkName,
+					"errorCode", event.ErrorCode,
+					"firstSeen", held.firstSeen)
+				fatalEventConfirmations.WithLabelValues(h.nodeName, h.checkName, confirmationResultConfirmed).Inc()
+
+				events = append(events, event)
+
+				continue
+			}
+
+			events = append(events, h.downgrade(held))
+		}
+
+		slog.Info("Holding fatal event until it recurs",
+			"check", h.checkName,
+			"errorCode", event.ErrorCode,
+			"window", h.window)
+
+		h.pending[key] = pendingConfirmation{event: event, firstSeen: now}
+	}
+
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	healthEvents.Events = events
+
+	return healthEvents, nil
+}
+
+// FlushExpired returns the held events whose confirmation window has passed, downgraded.
+func (h *confirmingHandler) FlushExpired() *pb.HealthEvents {
+	now := h.now()
+
+	var events []*pb.HealthEvent
+
+	for key, held := range h.pending {
+		if now.Sub(held.firstSeen) <= h.window {
+			continue
+		}
+
+		delete(h.pending, key)
+
+		events = append(events, h.downgrade(held))
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	return &pb.HealthEvents{Version: 1, Events: events}
+}
+
+// Held returns the events currently held for confirmation.
+func (h *confirmingHandler) Held() ([]heldEvent, error) {
+	held := make([]heldEvent, 0, len(h.pending))
+
+	for _, pending := range h.pending {
+		data, err := protojson.Marshal(pending.event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal held event: %w", err)
+		}
+
+		held = append(held, heldEvent{Event: data, FirstSeen: pending.firstSeen})
+	}
+
+	sort.Slice(held, func(i, j int) bool { return held[i].FirstSeen.Before(held[j].FirstSeen) })
+
+	return held, nil
+}
+
+// Restore holds the events saved by Held again, keeping the time each was first seen so the
+// confirmation window is not restarted.
+func (h *confirmingHandler) Restore(held []heldEvent) error {
+	for _, saved := range held {
+		event := &pb.HealthEvent{}
+		if err := protojson.Unmarshal(saved.Event, event); err != nil {
+			return fmt.Errorf("failed to unmarshal held event: %w", err)
+		}
+
+		h.pending[confirmationKey(event)] = pendingConfirmation{event: event, firstSeen: saved.FirstSeen}
+	}
+
+	return nil
+}
+
+// downgrade turns an unconfirmed fatal event into a non-fatal event that recommends no action.
+func (h *confirmingHandler) downgrade(held pendingConfirmation) *pb.HealthEvent {
+	slog.Info("Fatal event did not recur within the confirmation window, downgrading",
+		"check", h.checkName,
+		"errorCode", held.event.ErrorCode,
+		"firstSeen", held.firstSeen,
+		"window", h.window)
+	fatalEventConfirmations.WithLabelValues(h.nodeName, h.checkName, confirmationResultDowngraded).Inc()
+
+	event, _ := proto.Clone(held.event).(*pb.HealthEvent)
+	event.IsFatal = false
+	event.RecommendedAction = pb.RecommendedAction_NONE
+	event.Message = fmt.Sprintf("Not confirmed within %s: %s", h.window, event.Message)
+
+	return event
+}
+
+// confirmationKey identifies an error on a component: the error codes and the PCI and GPU UUID
+// entities. Other entities, such as XID 13 channel details, may differ between occurrences.
+func confirmationKey(event *pb.HealthEvent) string {
+	parts := []string{strings.Join(event.ErrorCode, ",")}
+
+	for _, entity := range event.EntitiesImpacted {
+		if entity.EntityType == "PCI" || entity.EntityType == "GPU_UUID" {
+			parts = append(parts, entity.EntityType+"="+entity.EntityValue)
+		}
+	}
+
+	sort.Strings(parts[1:])
+
+	return strings.Join(parts, "|")
+}
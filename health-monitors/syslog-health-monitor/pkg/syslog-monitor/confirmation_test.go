@@ -0,0 +1,233 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslogmonitor
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+)
+
+// lineHandler turns each line of the form "<xid> <pci>" into a fatal XID event, and "healthy <pci>"
+// into a healthy one.
+type lineHandler struct{}
+
+func (lineHandler) ProcessLine(message string) (*pb.HealthEvents, error) {
+	code, pci, _ := strings.Cut(message, " ")
+
+	event := &pb.HealthEvent{
+		CheckName:         XIDErrorCheck,
+		Message:           message,
+		ErrorCode:         []string{code},
+		EntitiesImpacted:  []*pb.Entity{{EntityType: "PCI", EntityValue: pci}},
+		IsFatal:           true,
+		RecommendedAction: pb.RecommendedAction_RESTART_VM,
+	}
+	if code == "healthy" {
+		event.ErrorCode = nil
+		event.IsFatal = false
+		event.IsHealthy = true
+		event.RecommendedAction = pb.RecommendedAction_NONE
+	}
+
+	return &pb.HealthEvents{Version: 1, Events: []*pb.HealthEvent{event}}, nil
+}
+
+func newTestConfirmingHandler(now *time.Time) *confirmingHandler {
+	h := newConfirmingHandler(lineHandler{}, TEST_NODE, XIDErrorCheck, 10*time.Minute)
+	h.now = func() time.Time { return *now }
+
+	return h
+}
+
+func TestConfirmingHandler_OneShotIsDowngraded(t *testing.T) {
+	now := time.Now()
+	h := newTestConfirmingHandler(&now)
+	downgraded := fatalEventConfirmations.WithLabelValues(TEST_NODE, XIDErrorCheck, confirmationResultDowngraded)
+	before := testutil.ToFloat64(downgraded)
+
+	healthEvents, err := h.ProcessLine("79 0000:03:00")
+	require.NoError(t, err)
+	assert.Nil(t, healthEvents, "the first occurrence is held back")
+
+	now = now.Add(5 * time.Minute)
+	assert.Nil(t, h.FlushExpired(), "the event is held until the window passes")
+
+	now = now.Add(6 * time.Minute)
+	healthEvents = h.FlushExpired()
+	require.NotNil(t, healthEvents)
+	require.Len(t, healthEvents.Events, 1)
+
+	event := healthEvents.Events[0]
+	assert.False(t, event.IsFatal)
+	assert.Equal(t, pb.RecommendedAction_NONE, event.RecommendedAction)
+	assert.Equal(t, []string{"79"}, event.ErrorCode)
+	assert.Equal(t, "Not confirmed within 10m0s: 79 0000:03:00", event.Message)
+	assert.Equal(t, before+1, testutil.ToFloat64(downgraded))
+
+	assert.Nil(t, h.FlushExpired(), "a downgraded event is released once")
+}
+
+func TestConfirmingHandler_RecurrenceQuarantines(t *testing.T) {
+	now := time.Now()
+	h := newTestConfirmingHandler(&now)
+	confirmed := fatalEventConfirmations.WithLabelValues(TEST_NODE, XIDErrorCheck, confirmationResultConfirmed)
+	before := testutil.ToFloat64(confirmed)
+
+	healthEvents, err := h.ProcessLine("79 0000:03:00")
+	require.NoError(t, err)
+	assert.Nil(t, healthEvents)
+
+	now = now.Add(2 * time.Minute)
+	healthEvents, err = h.ProcessLine("79 0000:03:00")
+	require.NoError(t, err)
+	require.NotNil(t, healthEvents)
+	require.Len(t, healthEvents.Events, 1)
+	assert.True(t, healthEvents.Events[0].IsFatal)
+	assert.Equal(t, pb.RecommendedAction_RESTART_VM, healthEvents.Events[0].RecommendedAction)
+	assert.Equal(t, before+1, testutil.ToFloat64(confirmed))
+
+	now = now.Add(time.Hour)
+	assert.Nil(t, h.FlushExpired(), "a confirmed event is not downgraded later")
+}
+
+func TestConfirmingHandler_RecurrenceAfterWindowIsHeldAgain(t *testing.T) {
+	now := time.Now()
+	h := newTestConfirmingHandler(&now)
+
+	_, err := h.ProcessLine("79 0000:03:00")
+	require.NoError(t, err)
+
+	now = now.Add(11 * time.Minute)
+	healthEvents, err := h.ProcessLine("79 0000:03:00")
+	require.NoError(t, err)
+	require.NotNil(t, healthEvents)
+	require.Len(t, healthEvents.Events, 1)
+	assert.False(t, healthEvents.Events[0].IsFatal, "the expired occurrence is downgraded")
+
+	now = now.Add(time.Minute)
+	healthEvents, err = h.ProcessLine("79 0000:03:00")
+	require.NoError(t, err)
+	require.NotNil(t, healthEvents)
+	assert.True(t, healthEvents.Events[0].IsFatal, "the new occurrence confirms the next one")
+}
+
+func TestConfirmingHandler_KeysByErrorAndComponent(t *testing.T) {
+	now := time.Now()
+	h := newTestConfirmingHandler(&now)
+
+	for _, line := range []string{"79 0000:03:00", "79 0000:04:00", "48 0000:03:00"} {
+		healthEvents, err := h.ProcessLine(line)
+		require.NoError(t, err)
+		assert.Nil(t, healthEvents, "%s does not confirm a different error or GPU", line)
+	}
+
+	healthEvents, err := h.ProcessLine("healthy 0000:03:00")
+	require.NoError(t, err)
+	require.NotNil(t, healthEvents, "healthy events pass through")
+	assert.True(t, healthEvents.Events[0].IsHealthy)
+
+	now = now.Add(time.Hour)
+	healthEvents = h.FlushExpired()
+	require.NotNil(t, healthEvents)
+	assert.Len(t, healthEvents.Events, 3)
+}
+
+func TestConfirmingHandler_HeldEventsSurviveRestore(t *testing.T) {
+	now := time.Now()
+	h := newTestConfirmingHandler(&now)
+
+	_, err := h.ProcessLine("79 0000:03:00")
+	require.NoError(t, err)
+
+	held, err := h.Held()
+	require.NoError(t, err)
+	require.Len(t, held, 1)
+
+	restored := newTestConfirmingHandler(&now)
+	require.NoError(t, restored.Restore(held))
+
+	now = now.Add(2 * time.Minute)
+	healthEvents, err := restored.ProcessLine("79 0000:03:00")
+	require.NoError(t, err)
+	require.NotNil(t, healthEvents, "the restored hold is confirmed by a recurrence")
+	assert.True(t, healthEvents.Events[0].IsFatal)
+
+	restored = newTestConfirmingHandler(&now)
+	require.NoError(t, restored.Restore(held))
+
+	now = now.Add(10 * time.Minute)
+	healthEvents = restored.FlushExpired()
+	require.NotNil(t, healthEvents, "the restored hold keeps its original window")
+	require.Len(t, healthEvents.Events, 1)
+	assert.False(t, healthEvents.Events[0].IsFatal)
+}
+
+func TestSyslogMonitor_SavesHeldEventsWithCursor(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	check := CheckDefinition{
+		Name:               XIDErrorCheck,
+		JournalPath:        TEST_JOURNAL_PATH,
+		ConfirmationWindow: 10 * time.Minute,
+	}
+
+	newMonitor := func() (*SyslogMonitor, *confirmingHandler) {
+		sm, err := NewSyslogMonitorWithFactory(
+			TEST_NODE,
+			[]CheckDefinition{check},
+			&mockPlatformConnectorClient{},
+			TEST_AGENT,
+			TEST_COMPONENT,
+			"60s",
+			stateFile,
+			NewMockJournalFactory(),
+			"http://localhost:8080",
+			"/tmp/metadata.json",
+			pb.ProcessingStrategy_EXECUTE_REMEDIATION,
+		)
+		require.NoError(t, err)
+
+		h, ok := sm.checkToHandlerMap[XIDErrorCheck].(*confirmingHandler)
+		require.True(t, ok)
+
+		h.handler = lineHandler{}
+
+		return sm, h
+	}
+
+	sm, h := newMonitor()
+
+	healthEvents, err := h.ProcessLine("79 0000:03:00")
+	require.NoError(t, err)
+	assert.Nil(t, healthEvents)
+
+	sm.checkLastCursors[XIDErrorCheck] = "cursor-1"
+	require.NoError(t, sm.saveCurrentState())
+
+	sm, h = newMonitor()
+	assert.Equal(t, "cursor-1", sm.checkLastCursors[XIDErrorCheck])
+
+	healthEvents, err = h.ProcessLine("79 0000:03:00")
+	require.NoError(t, err)
+	require.NotNil(t, healthEvents, "the hold read before the restart is confirmed after it")
+	assert.True(t, healthEvents.Events[0].IsFatal)
+}
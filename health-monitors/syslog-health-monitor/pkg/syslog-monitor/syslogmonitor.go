@@ -107,6 +107,13 @@ func NewSyslogMonitorWithFactory(
 		return nil, err
 	}
 
+	// Held events from a previous boot are dropped along with the cursors
+	if state.BootID == currentBootID {
+		if err := sm.restoreHeldEvents(state.HeldEvents); err != nil {
+			return nil, fmt.Errorf("failed to restore held events: %w", err)
+		}
+	}
+
 	// Handle boot ID changes (system reboot detection)
 	if err := sm.handleBootIDChange(state.BootID, currentBootID); err != nil {
 		return nil, fmt.Errorf("failed to handle boot ID change: %w", err)
@@ -140,6 +147,12 @@ func initHandlers(
 			continue
 		}
 
+		if check.ConfirmationWindow > 0 {
+			slog.Info("Fatal events require confirmation", "check", check.Name, "window", check.ConfirmationWindow)
+
+			handler = newConfirmingHandler(handler, nodeName, check.Name, check.ConfirmationWindow)
+		}
+
 		sm.checkToHandlerMap[check.Name] = handler
 	}
 
@@ -387,15 +400,66 @@ func (sm *SyslogMonitor) handleBootIDChange(oldBootID, newBootID string) error {
 
 // saveCurrentState saves the current state to the state file
 func (sm *SyslogMonitor) saveCurrentState() error {
+	heldEvents, err := sm.collectHeldEvents()
+	if err != nil {
+		return err
+	}
+
 	state := syslogMonitorState{
 		Version:          stateFileVersion,
 		BootID:           sm.currentBootID,
 		CheckLastCursors: sm.checkLastCursors,
+		HeldEvents:       heldEvents,
 	}
 
 	return saveState(sm.stateFilePath, state)
 }
 
+// collectHeldEvents returns the events each check's handler is holding back, by check name.
+func (sm *SyslogMonitor) collectHeldEvents() (map[string][]heldEvent, error) {
+	heldEvents := make(map[string][]heldEvent)
+
+	for checkName, handler := range sm.checkToHandlerMap {
+		expiring, ok := handler.(expiringHandler)
+		if !ok {
+			continue
+		}
+
+		held, err := expiring.Held()
+		if err != nil {
+			return nil, fmt.Errorf("check '%s': %w", checkName, err)
+		}
+
+		if len(held) > 0 {
+			heldEvents[checkName] = held
+		}
+	}
+
+	return heldEvents, nil
+}
+
+// restoreHeldEvents hands the events saved in the state file back to the handlers that held them.
+func (sm *SyslogMonitor) restoreHeldEvents(heldEvents map[string][]heldEvent) error {
+	for checkName, held := range heldEvents {
+		expiring, ok := sm.checkToHandlerMap[checkName].(expiringHandler)
+		if !ok {
+			slog.Warn("Dropping held events for a check that no longer holds events",
+				"check", checkName,
+				"count", len(held))
+
+			continue
+		}
+
+		if err := expiring.Restore(held); err != nil {
+			return fmt.Errorf("check '%s': %w", checkName, err)
+		}
+
+		slog.Info("Restored held events", "check", checkName, "count", len(held))
+	}
+
+	return nil
+}
+
 // executeCheck performs a single log check based on the provided definition
 func (sm *SyslogMonitor) executeCheck(check CheckDefinition) error {
 	slog.Info("Executing check", "check", check.Name)
@@ -422,6 +486,10 @@ func (sm *SyslogMonitor) executeCheck(check CheckDefinition) error {
 		return fmt.Errorf("failed to process journal entries for check %s: %w", check.Name, err)
 	}
 
+	if err := sm.flushExpiredEvents(check); err != nil {
+		return fmt.Errorf("failed to send expired events for check %s: %w", check.Name, err)
+	}
+
 	// Save state after successfully processing journal entries
 	if err := sm.saveCurrentState(); err != nil {
 		slog.Warn("Failed to save state after processing check",
@@ -1054,6 +1122,22 @@ func isRetryableError(err error) bool {
 	return false
 }
 
+// flushExpiredEvents sends the events a check's handler held back and has since released.
+func (sm *SyslogMonitor) flushExpiredEvents(check CheckDefinition) error {
+	handler, ok := sm.checkToHandlerMap[check.Name].(expiringHandler)
+	if !ok {
+		return nil
+	}
+
+	if healthEvents := handler.FlushExpired(); healthEvents != nil {
+		if err := sm.sendHealthEventWithRetry(healthEvents, 5, 2*time.Second); err != nil {
+			return fmt.Errorf("failed to send health event: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (sm *SyslogMonitor) handleSingleLine(check CheckDefinition, lineToEvaluate string) error {
 	if handler, ok := sm.checkToHandlerMap[check.Name]; ok {
 		healthEvents, err := handler.ProcessLine(lineToEvaluate)
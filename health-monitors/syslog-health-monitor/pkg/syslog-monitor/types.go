@@ -14,6 +14,8 @@
 package syslogmonitor
 
 import (
+	"time"
+
 	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
 	"github.com/nvidia/nvsentinel/health-monitors/syslog-health-monitor/pkg/types"
 )
@@ -41,6 +43,9 @@ type syslogMonitorState struct {
 	Version          int               `json:"version"`
 	BootID           string            `json:"boot_id"`
 	CheckLastCursors map[string]string `json:"check_last_cursors"`
+	// HeldEvents are the events each check holds for confirmation, saved with the cursors that
+	// passed them
+	HeldEvents map[string][]heldEvent `json:"held_events,omitempty"`
 }
 
 // SyslogMonitor monitors journal logs for error patterns
@@ -71,4 +76,7 @@ type CheckDefinition struct {
 	Name        string   `yaml:"name"`
 	Tags        []string `yaml:"tags"`
 	JournalPath string   `yaml:"journalPath"`
+	// ConfirmationWindow holds fatal events back until the same error recurs on the same component
+	// within this window; unconfirmed events are downgraded to non-fatal. Zero disables confirmation.
+	ConfirmationWindow time.Duration `yaml:"confirmationWindow"`
 }
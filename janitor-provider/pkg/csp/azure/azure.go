@@ -17,6 +17,7 @@ package azure
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -35,8 +36,21 @@ import (
 	"github.com/nvidia/nvsentinel/janitor-provider/pkg/model"
 )
 
+const (
+	defaultIMDSTimeout = 5 * time.Second
+	imdsMaxAttempts    = 3
+	imdsRetryDelay     = 500 * time.Millisecond
+)
+
 var (
 	_ model.CSPClient = (*Client)(nil)
+
+	// ErrIMDSUnreachable is returned when the instance metadata service could not be queried.
+	ErrIMDSUnreachable = errors.New("azure instance metadata service unreachable")
+	// ErrSubscriptionIDNotFound is returned when IMDS responded without a subscription ID.
+	ErrSubscriptionIDNotFound = errors.New("subscription ID not present in azure instance metadata")
+
+	imdsEndpoint = "http://169.254.169.254/metadata/instance"
 )
 
 // VMSSClientInterface defines the interface for VMSS operations we need
@@ -194,7 +208,7 @@ func createDefaultVMSSClient(ctx context.Context) (VMSSClientInterface, error) {
 	// Get the Azure subscription ID from environment variable or IMDS
 	subscriptionID, err := getSubscriptionID(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get Azure subscription ID: %w", err)
 	}
 
 	cred, err := azidentity.NewDefaultAzureCredential(nil)
@@ -228,15 +242,66 @@ func getSubscriptionID(ctx context.Context) (string, error) {
 		return subscriptionID, nil
 	}
 
+	return fetchSubscriptionID(ctx, imdsEndpoint, imdsTimeoutFromEnv())
+}
+
+// imdsTimeoutFromEnv returns the per-attempt IMDS timeout from AZURE_IMDS_TIMEOUT, falling back
+// to defaultIMDSTimeout when unset or invalid.
+func imdsTimeoutFromEnv() time.Duration {
+	value := os.Getenv("AZURE_IMDS_TIMEOUT")
+	if value == "" {
+		return defaultIMDSTimeout
+	}
+
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		slog.Warn("Invalid AZURE_IMDS_TIMEOUT, using default", "value", value, "default", defaultIMDSTimeout)
+		return defaultIMDSTimeout
+	}
+
+	return timeout
+}
+
+// fetchSubscriptionID reads the subscription ID from the instance metadata service, retrying
+// transient failures. Errors wrap ErrIMDSUnreachable when no usable response was received and
+// ErrSubscriptionIDNotFound when IMDS answered without a subscription ID.
+func fetchSubscriptionID(ctx context.Context, endpoint string, timeout time.Duration) (string, error) {
 	// pulled from https://github.com/Microsoft/azureimds/blob/master/imdssample.go
-	client := http.Client{Transport: &http.Transport{Proxy: nil}}
-
-	req, err := http.NewRequestWithContext(
-		ctx,
-		"GET",
-		"http://169.254.169.254/metadata/instance",
-		nil,
-	)
+	client := &http.Client{Transport: &http.Transport{Proxy: nil}, Timeout: timeout}
+
+	var lastErr error
+
+	for attempt := 1; attempt <= imdsMaxAttempts; attempt++ {
+		subscriptionID, err := querySubscriptionID(ctx, client, endpoint)
+		if err == nil {
+			return subscriptionID, nil
+		}
+
+		if errors.Is(err, ErrSubscriptionIDNotFound) {
+			return "", err
+		}
+
+		lastErr = err
+
+		slog.WarnContext(ctx, "Failed to query Azure IMDS for subscription ID",
+			"attempt", attempt, "maxAttempts", imdsMaxAttempts, "error", err)
+
+		if attempt == imdsMaxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("%w: %w", ErrIMDSUnreachable, ctx.Err())
+		case <-time.After(imdsRetryDelay):
+		}
+	}
+
+	return "", fmt.Errorf("%w after %d attempts: %w", ErrIMDSUnreachable, imdsMaxAttempts, lastErr)
+}
+
+func querySubscriptionID(ctx context.Context, client *http.Client, endpoint string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return "", fmt.Errorf("failed to create metadata request: %w", err)
 	}
@@ -259,6 +324,10 @@ func getSubscriptionID(ctx context.Context) (string, error) {
 		}
 	}()
 
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected IMDS response status: %s", resp.Status)
+	}
+
 	// now that we have the response get the subscription ID from it
 	var result struct {
 		Compute struct {
@@ -270,5 +339,9 @@ func getSubscriptionID(ctx context.Context) (string, error) {
 		return "", fmt.Errorf("failed to decode IMDS response: %w", err)
 	}
 
+	if result.Compute.SubscriptionID == "" {
+		return "", fmt.Errorf("%w: set AZURE_SUBSCRIPTION_ID or check the VM's metadata", ErrSubscriptionIDNotFound)
+	}
+
 	return result.Compute.SubscriptionID, nil
 }
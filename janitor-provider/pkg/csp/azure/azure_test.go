@@ -0,0 +1,89 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchSubscriptionIDRetriesTransientFailures(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "True", r.Header.Get("Metadata"))
+
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"compute":{"subscriptionId":"sub-123"}}`))
+	}))
+	defer server.Close()
+
+	subscriptionID, err := fetchSubscriptionID(context.Background(), server.URL, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "sub-123", subscriptionID)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestFetchSubscriptionIDUnreachable(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	_, err := fetchSubscriptionID(context.Background(), server.URL, 50*time.Millisecond)
+	require.ErrorIs(t, err, ErrIMDSUnreachable)
+	assert.NotErrorIs(t, err, ErrSubscriptionIDNotFound)
+	assert.Equal(t, int32(imdsMaxAttempts), calls.Load())
+}
+
+func TestFetchSubscriptionIDMissingFromResponse(t *testing.T) {
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_, _ = w.Write([]byte(`{"compute":{}}`))
+	}))
+	defer server.Close()
+
+	_, err := fetchSubscriptionID(context.Background(), server.URL, time.Second)
+	require.ErrorIs(t, err, ErrSubscriptionIDNotFound)
+	assert.NotErrorIs(t, err, ErrIMDSUnreachable)
+	assert.Equal(t, int32(1), calls.Load(), "a missing subscription ID should not be retried")
+}
+
+func TestIMDSTimeoutFromEnv(t *testing.T) {
+	t.Setenv("AZURE_IMDS_TIMEOUT", "")
+	assert.Equal(t, defaultIMDSTimeout, imdsTimeoutFromEnv())
+
+	t.Setenv("AZURE_IMDS_TIMEOUT", "2s")
+	assert.Equal(t, 2*time.Second, imdsTimeoutFromEnv())
+
+	t.Setenv("AZURE_IMDS_TIMEOUT", "not-a-duration")
+	assert.Equal(t, defaultIMDSTimeout, imdsTimeoutFromEnv())
+}
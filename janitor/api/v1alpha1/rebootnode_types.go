@@ -28,6 +28,10 @@ const (
 	ManualModeConditionType = "ManualMode"
 )
 
+// RebootNodeReasonNodeReadyTimeout is the NodeReady condition reason set when the node did not
+// return to ready state within the reboot timeout.
+const RebootNodeReasonNodeReadyTimeout = "Timeout"
+
 // RebootNodeSpec defines the desired state of RebootNode
 type RebootNodeSpec struct {
 	// Force indicates whether to force reboot the node
@@ -95,6 +99,70 @@ func (r *RebootNode) IsRebootInProgress() bool {
 	return rebootSignalSent && nodeNotReady
 }
 
+// IsNodeReadyTimeout reports whether the reboot failed because the node did not return to ready
+// state within the reboot timeout.
+func (r *RebootNode) IsNodeReadyTimeout() bool {
+	for _, condition := range r.Status.Conditions {
+		if condition.Type == RebootNodeConditionNodeReady {
+			return condition.Status == metav1.ConditionFalse && condition.Reason == RebootNodeReasonNodeReadyTimeout
+		}
+	}
+
+	return false
+}
+
+// RebootResult is the progress of a RebootNode as derived from its status
+type RebootResult string
+
+const (
+	// RebootResultPending means the reboot signal has not been sent yet
+	RebootResultPending RebootResult = "Pending"
+	// RebootResultInProgress means the reboot signal was sent and the node has not returned to ready yet
+	RebootResultInProgress RebootResult = "InProgress"
+	// RebootResultSucceeded means the node returned to ready state after the reboot
+	RebootResultSucceeded RebootResult = "Succeeded"
+	// RebootResultFailed means the reboot signal could not be sent or the node did not return to ready
+	RebootResultFailed RebootResult = "Failed"
+)
+
+// IsRebootComplete reports whether the RebootNode has reached a terminal state together with its result.
+// A reboot is complete once its CompletionTime is set or its conditions show a terminal outcome, so a
+// status that is only partially written is still classified correctly.
+func IsRebootComplete(r *RebootNode) (bool, RebootResult) {
+	if r == nil {
+		return false, RebootResultPending
+	}
+
+	var signalSent, nodeReady *metav1.Condition
+
+	for i := range r.Status.Conditions {
+		switch r.Status.Conditions[i].Type {
+		case RebootNodeConditionSignalSent:
+			signalSent = &r.Status.Conditions[i]
+		case RebootNodeConditionNodeReady:
+			nodeReady = &r.Status.Conditions[i]
+		}
+	}
+
+	if signalSent != nil && signalSent.Status == metav1.ConditionFalse {
+		return true, RebootResultFailed
+	}
+
+	if nodeReady != nil && nodeReady.Status == metav1.ConditionTrue {
+		return true, RebootResultSucceeded
+	}
+
+	if r.Status.CompletionTime != nil {
+		return true, RebootResultFailed
+	}
+
+	if signalSent != nil && signalSent.Status == metav1.ConditionTrue {
+		return false, RebootResultInProgress
+	}
+
+	return false, RebootResultPending
+}
+
 func (r *RebootNode) GetCSPReqRef() string {
 	for _, condition := range r.Status.Conditions {
 		if condition.Type == RebootNodeConditionSignalSent {
@@ -90,6 +90,112 @@ func TestRebootNode_IsRebootInProgress(t *testing.T) {
 	}
 }
 
+func TestIsRebootComplete(t *testing.T) {
+	completedAt := metav1.Now()
+
+	tests := []struct {
+		name             string
+		conditions       []metav1.Condition
+		completionTime   *metav1.Time
+		expectedComplete bool
+		expectedResult   RebootResult
+	}{
+		{
+			name:             "no conditions",
+			expectedComplete: false,
+			expectedResult:   RebootResultPending,
+		},
+		{
+			name: "initial unknown conditions",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionUnknown},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionUnknown},
+			},
+			expectedComplete: false,
+			expectedResult:   RebootResultPending,
+		},
+		{
+			name: "signal sent and waiting for node ready",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionUnknown},
+			},
+			expectedComplete: false,
+			expectedResult:   RebootResultInProgress,
+		},
+		{
+			name: "node ready after reboot",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionTrue, Reason: "Succeeded"},
+			},
+			completionTime:   &completedAt,
+			expectedComplete: true,
+			expectedResult:   RebootResultSucceeded,
+		},
+		{
+			name: "node ready before completion time is written",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionTrue},
+			},
+			expectedComplete: true,
+			expectedResult:   RebootResultSucceeded,
+		},
+		{
+			name: "node ready timed out",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionTrue},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionFalse, Reason: "Timeout"},
+			},
+			completionTime:   &completedAt,
+			expectedComplete: true,
+			expectedResult:   RebootResultFailed,
+		},
+		{
+			name: "reboot signal failed",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionFalse, Reason: "Failed"},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionUnknown},
+			},
+			completionTime:   &completedAt,
+			expectedComplete: true,
+			expectedResult:   RebootResultFailed,
+		},
+		{
+			name: "manual mode waiting for outside actor",
+			conditions: []metav1.Condition{
+				{Type: RebootNodeConditionSignalSent, Status: metav1.ConditionUnknown},
+				{Type: RebootNodeConditionNodeReady, Status: metav1.ConditionUnknown},
+				{Type: ManualModeConditionType, Status: metav1.ConditionTrue},
+			},
+			expectedComplete: false,
+			expectedResult:   RebootResultPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rn := &RebootNode{
+				Status: RebootNodeStatus{
+					Conditions:     tt.conditions,
+					CompletionTime: tt.completionTime,
+				},
+			}
+
+			complete, result := IsRebootComplete(rn)
+			assert.Equal(t, tt.expectedComplete, complete)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+
+	t.Run("nil RebootNode", func(t *testing.T) {
+		complete, result := IsRebootComplete(nil)
+		assert.False(t, complete)
+		assert.Equal(t, RebootResultPending, result)
+	})
+}
+
 func TestRebootNode_GetCSPReqRef(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -81,6 +81,7 @@ type runFlags struct {
 	secureMetrics                                    bool
 	enableHTTP2                                      bool
 	configFile                                       string
+	configReloadInterval                             time.Duration
 	leaseDuration                                    time.Duration
 	renewDeadline                                    time.Duration
 	retryPeriod                                      time.Duration
@@ -205,11 +206,16 @@ func run() error {
 		return err
 	}
 
+	rebootNodeTunables := config.NewTunableStore(cfg.RebootNode.Tunables())
+	terminateNodeTunables := config.NewTunableStore(cfg.TerminateNode.Tunables())
+	gpuResetTunables := config.NewTunableStore(cfg.GPUReset.Tunables())
+
 	if err = (&controller.RebootNodeReconciler{
 		Client:        mgr.GetClient(),
 		Scheme:        mgr.GetScheme(),
 		Config:        &cfg.RebootNode,
 		LockNamespace: podNamespace,
+		Tunables:      rebootNodeTunables,
 	}).SetupWithManager(mgr); err != nil {
 		slog.Error("Unable to create controller", "controller", "RebootNode", "error", err)
 
@@ -221,6 +227,7 @@ func run() error {
 		Scheme:        mgr.GetScheme(),
 		Config:        &cfg.TerminateNode,
 		LockNamespace: podNamespace,
+		Tunables:      terminateNodeTunables,
 	}).SetupWithManager(mgr); err != nil {
 		slog.Error("Unable to create controller", "controller", "TerminateNode", "error", err)
 
@@ -232,6 +239,7 @@ func run() error {
 		Scheme:        mgr.GetScheme(),
 		Config:        &cfg.GPUReset,
 		LockNamespace: podNamespace,
+		Tunables:      gpuResetTunables,
 	}).SetupWithManager(mgr); err != nil {
 		slog.Error("unable to create controller", "controller", "GPUReset", "error", err)
 
@@ -240,6 +248,11 @@ func run() error {
 
 	slog.Info("RebootNode, TerminateNode, and GPUReset controllers registered")
 
+	if err = registerConfigReloader(mgr, flags, podNamespace, cfg,
+		rebootNodeTunables, terminateNodeTunables, gpuResetTunables); err != nil {
+		return err
+	}
+
 	// Register TTL reconcilers for each maintenance CR kind. See
 	// docs/designs/037-janitor-cr-ttl-cleanup.md for the design.
 	if err = registerTTLReconcilers(mgr, flags.enableTTL, flags.defaultTTL); err != nil {
@@ -330,6 +343,9 @@ func parseFlags() runFlags {
 	flag.BoolVar(&rf.enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 	flag.StringVar(&rf.configFile, "config", "", "The path to the configuration file.")
+	flag.DurationVar(&rf.configReloadInterval, "config-reload-interval", 0,
+		"How often the configuration file is checked for changes to controller timeouts and manual mode. "+
+			"Set to 0 to disable reloading.")
 
 	// Leader election flags: defaulting to high values; janitor is not sensitive to slow leader transitions.
 	flag.DurationVar(&rf.leaseDuration, "lease-duration", 90*time.Second,
@@ -362,6 +378,7 @@ func parseFlags() runFlags {
 		"config-bind-address", rf.configAddr,
 		"leader-elect", rf.enableLeaderElection,
 		"config", rf.configFile,
+		"config-reload-interval", rf.configReloadInterval,
 		"secure-metrics", rf.secureMetrics,
 		"enable-http2", rf.enableHTTP2,
 		"webhook-cert-path", rf.webhookCertPath,
@@ -500,6 +517,33 @@ func setupTLSAndServers(rf runFlags) (serverSetup, error) {
 	return result, nil
 }
 
+// registerConfigReloader adds a runnable that applies changes to controller tunables in the
+// config file without a restart.
+func registerConfigReloader(mgr ctrl.Manager, flags runFlags, podNamespace string, cfg *config.Config,
+	rebootNode, terminateNode, gpuReset *config.TunableStore) error {
+	if flags.configReloadInterval <= 0 || flags.configFile == "" {
+		slog.Info("Config reloading disabled; configuration changes require a restart")
+
+		return nil
+	}
+
+	reloader, err := config.NewReloader(flags.configFile, podNamespace, flags.configReloadInterval, cfg,
+		rebootNode, terminateNode, gpuReset)
+	if err != nil {
+		slog.Error("Unable to create config reloader", "error", err)
+
+		return err
+	}
+
+	if err := mgr.Add(reloader); err != nil {
+		slog.Error("Unable to add config reloader to manager", "error", err)
+
+		return err
+	}
+
+	return nil
+}
+
 // registerTTLReconcilers wires a generic TTL reconciler for each maintenance
 // CR kind when enabled is true. A zero defaultTTL means no system default —
 // per-CR TTL annotations still take effect. The reconcilers share janitor's
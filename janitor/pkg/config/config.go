@@ -40,6 +40,7 @@ type Config struct {
 // GlobalConfig contains global janitor settings
 type GlobalConfig struct {
 	Timeout              time.Duration `mapstructure:"timeout" json:"timeout"`
+	ReconcileTimeout     time.Duration `mapstructure:"reconcileTimeout" json:"reconcileTimeout"`
 	ManualMode           *bool         `mapstructure:"manualMode" json:"manualMode"`
 	Nodes                NodeConfig    `mapstructure:"nodes" json:"nodes"`
 	CSPProviderHost      string        `mapstructure:"cspProviderHost" json:"cspProviderHost"`
@@ -61,6 +62,8 @@ type RebootNodeControllerConfig struct {
 	ManualMode *bool
 	// Timeout for reboot operations
 	Timeout time.Duration
+	// ReconcileTimeout bounds a single reconcile; slower reconciles are cancelled and requeued
+	ReconcileTimeout time.Duration
 	// Exclusions defines label selectors for nodes that should be excluded from reboot operations
 	// Nodes matching any of these label selectors will be rejected by the admission webhook
 	Exclusions []metav1.LabelSelector
@@ -82,6 +85,8 @@ type TerminateNodeControllerConfig struct {
 	ManualMode *bool
 	// Timeout for terminate operations
 	Timeout time.Duration
+	// ReconcileTimeout bounds a single reconcile; slower reconciles are cancelled and requeued
+	ReconcileTimeout time.Duration
 	// NodeExclusions defines label selectors for nodes that should be excluded from terminate operations
 	// Nodes matching any of these label selectors will be rejected by the admission webhook
 	Exclusions []metav1.LabelSelector
@@ -97,13 +102,14 @@ type TerminateNodeControllerConfig struct {
 
 // GPUResetControllerConfig contains configuration for gpu reset controller
 type GPUResetControllerConfig struct {
-	Enabled         bool                   `mapstructure:"enabled" json:"enabled"`
-	ManualMode      *bool                  `mapstructure:"manualMode" json:"manualMode"`
-	Timeout         time.Duration          `mapstructure:"timeout" json:"timeout"`
-	Mock            bool                   `mapstructure:"mock" json:"mock"`
-	Exclusions      []metav1.LabelSelector `mapstructure:"exclusions" json:"exclusions"`
-	CSPProviderHost string                 `mapstructure:"cspProviderHost" json:"cspProviderHost"`
-	ServiceManager  gpuservices.Manager    `mapstructure:"serviceManager" json:"serviceManager"`
+	Enabled          bool                   `mapstructure:"enabled" json:"enabled"`
+	ManualMode       *bool                  `mapstructure:"manualMode" json:"manualMode"`
+	Timeout          time.Duration          `mapstructure:"timeout" json:"timeout"`
+	ReconcileTimeout time.Duration          `mapstructure:"reconcileTimeout" json:"reconcileTimeout"`
+	Mock             bool                   `mapstructure:"mock" json:"mock"`
+	Exclusions       []metav1.LabelSelector `mapstructure:"exclusions" json:"exclusions"`
+	CSPProviderHost  string                 `mapstructure:"cspProviderHost" json:"cspProviderHost"`
+	ServiceManager   gpuservices.Manager    `mapstructure:"serviceManager" json:"serviceManager"`
 	// reset ResetJob will be used to construct the ResolvedJobTemplate from the default Job template
 	ResetJob            ResetJobConfig `mapstructure:"resetJob" json:"resetJob"`
 	ResolvedJobTemplate *batchv1.JobTemplateSpec
@@ -73,6 +73,7 @@ gpuResetController:
 
 	// Verify global config
 	assert.Equal(t, 30*time.Minute, config.Global.Timeout)
+	assert.Equal(t, 2*time.Minute, config.Global.ReconcileTimeout)
 	assert.True(t, *config.Global.ManualMode)
 	assert.Len(t, config.Global.Nodes.Exclusions, 1)
 	assert.Equal(t, "production", config.Global.Nodes.Exclusions[0].MatchLabels["environment"])
@@ -83,6 +84,7 @@ gpuResetController:
 	assert.True(t, config.RebootNode.Enabled)
 	assert.True(t, *config.RebootNode.ManualMode)
 	assert.Equal(t, config.Global.Timeout, config.RebootNode.Timeout)
+	assert.Equal(t, config.Global.ReconcileTimeout, config.RebootNode.ReconcileTimeout)
 	assert.Equal(t, config.Global.Nodes.Exclusions, config.RebootNode.Exclusions)
 	assert.Equal(t, config.Global.CSPProviderHost, config.RebootNode.CSPProviderHost)
 
@@ -90,6 +92,7 @@ gpuResetController:
 	assert.True(t, config.TerminateNode.Enabled)
 	assert.True(t, *config.TerminateNode.ManualMode)
 	assert.Equal(t, config.Global.Timeout, config.TerminateNode.Timeout)
+	assert.Equal(t, config.Global.ReconcileTimeout, config.TerminateNode.ReconcileTimeout)
 	assert.Equal(t, config.Global.Nodes.Exclusions, config.TerminateNode.Exclusions)
 	assert.Equal(t, config.Global.CSPProviderHost, config.TerminateNode.CSPProviderHost)
 
@@ -97,6 +100,7 @@ gpuResetController:
 	assert.False(t, config.GPUReset.Enabled)
 	assert.True(t, *config.GPUReset.ManualMode)
 	assert.Equal(t, config.Global.Timeout, config.GPUReset.Timeout)
+	assert.Equal(t, config.Global.ReconcileTimeout, config.GPUReset.ReconcileTimeout)
 	assert.Equal(t, config.Global.Nodes.Exclusions, config.GPUReset.Exclusions)
 	assert.Equal(t, config.Global.CSPProviderHost, config.GPUReset.CSPProviderHost)
 }
@@ -46,6 +46,10 @@ func applyGlobalDefaults(config *Config) {
 		config.Global.Timeout = 30 * time.Minute
 	}
 
+	if config.Global.ReconcileTimeout == 0 {
+		config.Global.ReconcileTimeout = 2 * time.Minute
+	}
+
 	if config.Global.ManualMode == nil {
 		config.Global.ManualMode = ptr.To(false)
 	}
@@ -63,6 +67,18 @@ func applyTimeoutDefaults(config *Config) {
 	if config.GPUReset.Timeout == 0 {
 		config.GPUReset.Timeout = config.Global.Timeout
 	}
+
+	if config.RebootNode.ReconcileTimeout == 0 {
+		config.RebootNode.ReconcileTimeout = config.Global.ReconcileTimeout
+	}
+
+	if config.TerminateNode.ReconcileTimeout == 0 {
+		config.TerminateNode.ReconcileTimeout = config.Global.ReconcileTimeout
+	}
+
+	if config.GPUReset.ReconcileTimeout == 0 {
+		config.GPUReset.ReconcileTimeout = config.Global.ReconcileTimeout
+	}
 }
 
 func applyManualModeDefaults(config *Config) {
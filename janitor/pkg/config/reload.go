@@ -0,0 +1,233 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"time"
+)
+
+// Tunables are the controller settings that can be changed without restarting janitor.
+// All other settings (enabled controllers, exclusions, CSP provider connection, GPU reset
+// job template) are only read at startup.
+type Tunables struct {
+	Timeout          time.Duration `json:"timeout"`
+	ReconcileTimeout time.Duration `json:"reconcileTimeout"`
+	ManualMode       bool          `json:"manualMode"`
+}
+
+// TunableStore holds the current Tunables of one controller and is safe for concurrent use.
+type TunableStore struct {
+	current atomic.Pointer[Tunables]
+}
+
+// NewTunableStore creates a TunableStore initialized to t.
+func NewTunableStore(t Tunables) *TunableStore {
+	s := &TunableStore{}
+	s.Set(t)
+
+	return s
+}
+
+// Get returns the current tunables.
+func (s *TunableStore) Get() Tunables {
+	return *s.current.Load()
+}
+
+// Set replaces the current tunables.
+func (s *TunableStore) Set(t Tunables) {
+	s.current.Store(&t)
+}
+
+// Tunables returns the runtime tunables of the reboot node controller.
+func (c *RebootNodeControllerConfig) Tunables() Tunables {
+	return newTunables(c.Timeout, c.ReconcileTimeout, c.ManualMode)
+}
+
+// Tunables returns the runtime tunables of the terminate node controller.
+func (c *TerminateNodeControllerConfig) Tunables() Tunables {
+	return newTunables(c.Timeout, c.ReconcileTimeout, c.ManualMode)
+}
+
+// Tunables returns the runtime tunables of the GPU reset controller.
+func (c *GPUResetControllerConfig) Tunables() Tunables {
+	return newTunables(c.Timeout, c.ReconcileTimeout, c.ManualMode)
+}
+
+func newTunables(timeout, reconcileTimeout time.Duration, manualMode *bool) Tunables {
+	return Tunables{
+		Timeout:          timeout,
+		ReconcileTimeout: reconcileTimeout,
+		ManualMode:       manualMode != nil && *manualMode,
+	}
+}
+
+// Reloader polls the config file and applies changed tunables to the running controllers.
+// Polling is used instead of file notifications because ConfigMap volumes are updated by
+// swapping symlinks, which inotify watches on the file itself do not observe.
+type Reloader struct {
+	path      string
+	namespace string
+	interval  time.Duration
+
+	rebootNode    *TunableStore
+	terminateNode *TunableStore
+	gpuReset      *TunableStore
+
+	lastHash   [sha256.Size]byte
+	lastStatic []byte
+}
+
+// NewReloader creates a Reloader for the config file at path. The initial config is the one
+// the controllers were started with.
+func NewReloader(path, namespace string, interval time.Duration, initial *Config,
+	rebootNode, terminateNode, gpuReset *TunableStore) (*Reloader, error) {
+	r := &Reloader{
+		path:          path,
+		namespace:     namespace,
+		interval:      interval,
+		rebootNode:    rebootNode,
+		terminateNode: terminateNode,
+		gpuReset:      gpuReset,
+	}
+
+	hash, err := r.hashFile()
+	if err != nil {
+		return nil, err
+	}
+
+	static, err := staticSettings(initial)
+	if err != nil {
+		return nil, err
+	}
+
+	r.lastHash = hash
+	r.lastStatic = static
+
+	return r, nil
+}
+
+// Start polls the config file until ctx is cancelled. It implements manager.Runnable.
+func (r *Reloader) Start(ctx context.Context) error {
+	slog.Info("Watching config file for tunable changes", "path", r.path, "interval", r.interval)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.Reload(); err != nil {
+				slog.Error("Failed to reload config, keeping current tunables", "path", r.path, "error", err)
+			}
+		}
+	}
+}
+
+// NeedLeaderElection returns false so that tunables are kept current on standby replicas too.
+func (r *Reloader) NeedLeaderElection() bool {
+	return false
+}
+
+// Reload re-reads the config file if it changed and applies the new tunables. An invalid
+// config is rejected as a whole and the current tunables stay in effect.
+func (r *Reloader) Reload() error {
+	hash, err := r.hashFile()
+	if err != nil {
+		return err
+	}
+
+	if hash == r.lastHash {
+		return nil
+	}
+
+	cfg, err := LoadConfig(r.path, r.namespace)
+	if err != nil {
+		return err
+	}
+
+	r.lastHash = hash
+
+	applyTunables("rebootnode", r.rebootNode, cfg.RebootNode.Tunables())
+	applyTunables("terminatenode", r.terminateNode, cfg.TerminateNode.Tunables())
+	applyTunables("gpureset", r.gpuReset, cfg.GPUReset.Tunables())
+
+	static, err := staticSettings(cfg)
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(static, r.lastStatic) {
+		slog.Warn("Config changes outside of timeout, reconcileTimeout and manualMode require a restart to apply",
+			"path", r.path)
+
+		r.lastStatic = static
+	}
+
+	return nil
+}
+
+func applyTunables(controller string, store *TunableStore, next Tunables) {
+	if store == nil {
+		return
+	}
+
+	current := store.Get()
+	if current == next {
+		return
+	}
+
+	store.Set(next)
+
+	slog.Info("Applied reloaded controller tunables",
+		"controller", controller,
+		"old", current,
+		"new", next)
+}
+
+func (r *Reloader) hashFile() ([sha256.Size]byte, error) {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return [sha256.Size]byte{}, fmt.Errorf("failed to read config file %s: %w", r.path, err)
+	}
+
+	return sha256.Sum256(data), nil
+}
+
+// staticSettings serializes cfg with the tunables cleared, so that changes to settings that
+// are only read at startup can be detected.
+func staticSettings(cfg *Config) ([]byte, error) {
+	static := *cfg
+	static.Global.Timeout, static.Global.ReconcileTimeout, static.Global.ManualMode = 0, 0, nil
+	static.RebootNode.Timeout, static.RebootNode.ReconcileTimeout, static.RebootNode.ManualMode = 0, 0, nil
+	static.TerminateNode.Timeout, static.TerminateNode.ReconcileTimeout, static.TerminateNode.ManualMode = 0, 0, nil
+	static.GPUReset.Timeout, static.GPUReset.ReconcileTimeout, static.GPUReset.ManualMode = 0, 0, nil
+
+	data, err := json.Marshal(static)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	return data, nil
+}
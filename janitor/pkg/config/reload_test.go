@@ -0,0 +1,90 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloader_Reload(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "janitor-config.yaml")
+	write := func(content string) {
+		require.NoError(t, os.WriteFile(configPath, []byte(content), 0644))
+	}
+
+	write(`
+global:
+  timeout: 30m
+  reconcileTimeout: 2m
+rebootNodeController:
+  enabled: true
+terminateNodeController:
+  enabled: true
+  timeout: 45m
+`)
+
+	cfg, err := LoadConfig(configPath, testNamespace)
+	require.NoError(t, err)
+
+	rebootNode := NewTunableStore(cfg.RebootNode.Tunables())
+	terminateNode := NewTunableStore(cfg.TerminateNode.Tunables())
+	gpuReset := NewTunableStore(cfg.GPUReset.Tunables())
+
+	reloader, err := NewReloader(configPath, testNamespace, time.Minute, cfg, rebootNode, terminateNode, gpuReset)
+	require.NoError(t, err)
+
+	// Unchanged file is a no-op.
+	require.NoError(t, reloader.Reload())
+	assert.Equal(t, Tunables{Timeout: 30 * time.Minute, ReconcileTimeout: 2 * time.Minute}, rebootNode.Get())
+
+	// Global changes cascade to controllers without an override, and a change to a
+	// startup-only setting does not prevent the tunables from being applied.
+	write(`
+global:
+  timeout: 10m
+  reconcileTimeout: 30s
+  manualMode: true
+rebootNodeController:
+  enabled: false
+terminateNodeController:
+  enabled: true
+  timeout: 45m
+`)
+
+	require.NoError(t, reloader.Reload())
+
+	assert.Equal(t, Tunables{Timeout: 10 * time.Minute, ReconcileTimeout: 30 * time.Second, ManualMode: true},
+		rebootNode.Get())
+	assert.Equal(t, Tunables{Timeout: 45 * time.Minute, ReconcileTimeout: 30 * time.Second, ManualMode: true},
+		terminateNode.Get())
+	assert.Equal(t, Tunables{Timeout: 10 * time.Minute, ReconcileTimeout: 30 * time.Second, ManualMode: true},
+		gpuReset.Get())
+
+	// An invalid config is rejected and the current tunables stay in effect.
+	write(`
+global:
+  timeout: 5m
+  unknownField: true
+`)
+
+	require.Error(t, reloader.Reload())
+	assert.Equal(t, 10*time.Minute, rebootNode.Get().Timeout)
+}
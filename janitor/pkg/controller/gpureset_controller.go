@@ -90,6 +90,8 @@ type GPUResetReconciler struct {
 	// NodeLock provides node-level locking across Janitor controllers
 	NodeLock      distributedlock.NodeLock
 	LockNamespace string
+	// Tunables holds the reloadable settings. When nil, they are read from Config.
+	Tunables *config.TunableStore
 	// resetSessionSpans holds one long-lived "reset_session" span per CR, keyed by CR name.
 	// Started on the first reconcile, ended on completion/failure/deletion.
 	resetSessionSpans sync.Map
@@ -106,6 +108,27 @@ type GPUResetReconciler struct {
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete;patch
 
 func (r *GPUResetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return reconcileWithTimeout(ctx, "gpureset", r.tunables().ReconcileTimeout,
+		func(ctx context.Context) (ctrl.Result, error) {
+			return r.reconcile(ctx, req)
+		})
+}
+
+// tunables returns the current reloadable settings of the controller
+func (r *GPUResetReconciler) tunables() config.Tunables {
+	if r.Tunables != nil {
+		return r.Tunables.Get()
+	}
+
+	if r.Config == nil {
+		return config.Tunables{}
+	}
+
+	return r.Config.Tunables()
+}
+
+// reconcile performs a single reconciliation of the GPUReset CR, bounded by Reconcile's timeout.
+func (r *GPUResetReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	var gpuReset v1alpha1.GPUReset
 	if err := r.Get(ctx, req.NamespacedName, &gpuReset); err != nil {
 		if apierrors.IsNotFound(err) {
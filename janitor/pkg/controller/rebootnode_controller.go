@@ -44,6 +44,7 @@ import (
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
 	"github.com/nvidia/nvsentinel/janitor/pkg/distributedlock"
 	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/janitor/pkg/ttl"
 )
 
 // cspProviderDialFunc creates a CSP provider client and returns a cleanup function.
@@ -57,6 +58,9 @@ type RebootNodeReconciler struct {
 	NodeLock      distributedlock.NodeLock
 	LockNamespace string
 
+	// Tunables holds the reloadable settings. When nil, they are read from Config.
+	Tunables *config.TunableStore
+
 	// dialProviderFunc overrides the default gRPC dial behavior.
 	// Used in tests to inject a mock CSP client.
 	dialProviderFunc cspProviderDialFunc
@@ -81,9 +85,17 @@ const requeueBackoffForTransientCSPError = 15 * time.Second
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
+func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return reconcileWithTimeout(ctx, "rebootnode", r.tunables().ReconcileTimeout,
+		func(ctx context.Context) (ctrl.Result, error) {
+			return r.reconcile(ctx, req)
+		})
+}
+
+// reconcile performs a single reconciliation of the RebootNode CR, bounded by Reconcile's timeout.
 //
 //nolint:dupl // Structural duplication with TerminateNode is acceptable - different business logic
-func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *RebootNodeReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	// Get the RebootNode object
 	var rebootNode janitordgxcnvidiacomv1alpha1.RebootNode
 	if err := r.Get(ctx, req.NamespacedName, &rebootNode); err != nil {
@@ -124,6 +136,10 @@ func (r *RebootNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	r.endRebootSession(crKey)
 
+	if err := r.preserveIfNodeReadyTimedOut(ctx, &rebootNode); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	retryUnlock := r.NodeLock.CheckUnlock(ctx, &rebootNode, rebootNode.Spec.NodeName)
 	if retryUnlock {
 		return ctrl.Result{RequeueAfter: time.Second * 2}, nil
@@ -227,7 +243,8 @@ func (r *RebootNodeReconciler) reconcileHelper(
 		return ctrl.Result{}, err
 	}
 
-	if rebootNode.Status.CompletionTime != nil {
+	if complete, rebootResult := janitordgxcnvidiacomv1alpha1.IsRebootComplete(rebootNode); complete {
+		slog.InfoContext(ctx, "Reboot completed", "node", rebootNode.Spec.NodeName, "result", rebootResult)
 		r.endRebootSession(rebootNode.Name)
 	}
 
@@ -359,14 +376,46 @@ func (r *RebootNodeReconciler) handleRebootInProgress(
 		)
 
 		slog.ErrorContext(ctx, "Node reboot timed out", "node", node.Name, "timeout", r.getRebootTimeout())
+		metrics.RebootNodeReadyTimeoutsTotal.WithLabelValues(node.Name).Inc()
 
-		return r.completeNodeReadyCheck(rebootNode, node, metav1.ConditionFalse, "Timeout",
+		return r.completeNodeReadyCheck(rebootNode, node, metav1.ConditionFalse,
+			janitordgxcnvidiacomv1alpha1.RebootNodeReasonNodeReadyTimeout,
 			"Node failed to return to ready state after timeout duration", metrics.StatusFailed)
 	}
 
 	return ctrl.Result{RequeueAfter: 60 * time.Second}
 }
 
+// preserveIfNodeReadyTimedOut keeps a RebootNode whose node never became ready again from being
+// deleted by the TTL reconciler. The failed CR stays as the record of the bad boot, and any health
+// event raised for it keeps the node quarantined, until an operator deletes it.
+func (r *RebootNodeReconciler) preserveIfNodeReadyTimedOut(
+	ctx context.Context, rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode,
+) error {
+	if !rebootNode.IsNodeReadyTimeout() || rebootNode.GetAnnotations()[ttl.PreserveAnnotation] == "true" {
+		return nil
+	}
+
+	patch := client.MergeFrom(rebootNode.DeepCopy())
+
+	annotations := rebootNode.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	annotations[ttl.PreserveAnnotation] = "true"
+	rebootNode.SetAnnotations(annotations)
+
+	if err := r.Patch(ctx, rebootNode, patch); err != nil {
+		return fmt.Errorf("preserving RebootNode %q after node ready timeout: %w", rebootNode.Name, err)
+	}
+
+	slog.InfoContext(ctx, "Preserved RebootNode of node that did not return to ready state",
+		"rebootNode", rebootNode.Name, "node", rebootNode.Spec.NodeName)
+
+	return nil
+}
+
 func (r *RebootNodeReconciler) completeNodeReadyCheck(
 	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, node *corev1.Node,
 	conditionStatus metav1.ConditionStatus, reason, message, metricsStatus string,
@@ -388,7 +437,7 @@ func (r *RebootNodeReconciler) checkNodeReadyFromCSP(
 	ctx context.Context, cspClient cspv1alpha1.CSPProviderServiceClient,
 	rebootNode *janitordgxcnvidiacomv1alpha1.RebootNode, nodeName string,
 ) (bool, error) {
-	if *r.Config.ManualMode {
+	if r.tunables().ManualMode {
 		return true, nil
 	}
 
@@ -425,7 +474,7 @@ func (r *RebootNodeReconciler) handleRebootNotStarted(
 		return ctrl.Result{RequeueAfter: 30 * time.Second}
 	}
 
-	if *r.Config.ManualMode {
+	if r.tunables().ManualMode {
 		return r.handleManualMode(ctx, rebootNode, node)
 	}
 
@@ -579,12 +628,25 @@ func (r *RebootNodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
+// tunables returns the current reloadable settings of the controller
+func (r *RebootNodeReconciler) tunables() config.Tunables {
+	if r.Tunables != nil {
+		return r.Tunables.Get()
+	}
+
+	if r.Config == nil {
+		return config.Tunables{}
+	}
+
+	return r.Config.Tunables()
+}
+
 // getRebootTimeout returns the timeout for reboot operations
 func (r *RebootNodeReconciler) getRebootTimeout() time.Duration {
-	cfg := r.Config
-	if cfg == nil || cfg.Timeout == 0 {
+	timeout := r.tunables().Timeout
+	if timeout == 0 {
 		return 30 * time.Minute // fallback default
 	}
 
-	return cfg.Timeout
+	return timeout
 }
@@ -18,24 +18,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	cspv1alpha1 "github.com/nvidia/nvsentinel/api/gen/go/csp/v1alpha1"
 	janitordgxcnvidiacomv1alpha1 "github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
 	"github.com/nvidia/nvsentinel/janitor/pkg/distributedlock"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
+	"github.com/nvidia/nvsentinel/janitor/pkg/ttl"
 )
 
 func TestRebootNodeReconciler_getRebootTimeout(t *testing.T) {
@@ -105,6 +114,125 @@ func Test_isTransientGRPCError(t *testing.T) {
 	}
 }
 
+func TestRebootNodeReconciler_ReconcileTimeout(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := janitordgxcnvidiacomv1alpha1.AddToScheme(s); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+
+	// A Get that only returns once its context is cancelled simulates a hung API call.
+	slowClient := fake.NewClientBuilder().
+		WithScheme(s).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, _ client.WithWatch, _ client.ObjectKey,
+				_ client.Object, _ ...client.GetOption) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		}).
+		Build()
+
+	r := &RebootNodeReconciler{
+		Client: slowClient,
+		Scheme: s,
+		Config: &config.RebootNodeControllerConfig{ReconcileTimeout: 50 * time.Millisecond},
+	}
+
+	before := testutil.ToFloat64(metrics.ReconcileTimeoutsTotal.WithLabelValues("rebootnode"))
+
+	result, err := r.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: "slow-reboot"},
+	})
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v, want nil", err)
+	}
+
+	if result.RequeueAfter != requeueAfterReconcileTimeout {
+		t.Errorf("Reconcile() RequeueAfter = %v, want %v", result.RequeueAfter, requeueAfterReconcileTimeout)
+	}
+
+	after := testutil.ToFloat64(metrics.ReconcileTimeoutsTotal.WithLabelValues("rebootnode"))
+	if after != before+1 {
+		t.Errorf("janitor_reconcile_timeouts_total = %v, want %v", after, before+1)
+	}
+}
+
+func TestRebootNodeReconciler_ReloadedTunables(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := janitordgxcnvidiacomv1alpha1.AddToScheme(s); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig := func(reconcileTimeout string) {
+		t.Helper()
+
+		content := "global:\n  reconcileTimeout: " + reconcileTimeout + "\nrebootNodeController:\n  enabled: true\n"
+		if err := os.WriteFile(configPath, []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write config: %v", err)
+		}
+	}
+
+	writeConfig("1m")
+
+	cfg, err := config.LoadConfig(configPath, "nvsentinel")
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	tunables := config.NewTunableStore(cfg.RebootNode.Tunables())
+
+	reloader, err := config.NewReloader(configPath, "nvsentinel", time.Minute, cfg, tunables, nil, nil)
+	if err != nil {
+		t.Fatalf("NewReloader() error = %v", err)
+	}
+
+	// The Get records the deadline of the reconcile context, which is bounded by reconcileTimeout.
+	var deadline time.Time
+
+	r := &RebootNodeReconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(s).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey,
+					obj client.Object, opts ...client.GetOption) error {
+					deadline, _ = ctx.Deadline()
+					return c.Get(ctx, key, obj, opts...)
+				},
+			}).
+			Build(),
+		Scheme:   s,
+		Config:   &cfg.RebootNode,
+		Tunables: tunables,
+	}
+
+	reconcileAndCheckTimeout := func(want time.Duration) {
+		t.Helper()
+
+		start := time.Now()
+
+		if _, err := r.Reconcile(context.Background(), reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: "missing"},
+		}); err != nil {
+			t.Fatalf("Reconcile() error = %v", err)
+		}
+
+		if got := deadline.Sub(start); got < want-time.Second || got > want+time.Second {
+			t.Errorf("reconcile deadline = %v after start, want about %v", got, want)
+		}
+	}
+
+	reconcileAndCheckTimeout(time.Minute)
+
+	writeConfig("5m")
+
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("Reload() error = %v", err)
+	}
+
+	reconcileAndCheckTimeout(5 * time.Minute)
+}
+
 var _ = Describe("RebootNode Controller", func() {
 	var (
 		ctx            context.Context
@@ -305,6 +433,43 @@ var _ = Describe("RebootNode Controller", func() {
 			Expect(updatedRebootNode.IsRebootInProgress()).To(BeTrue())
 		})
 
+		It("should fail, count and preserve the reboot when the node stays not ready past the timeout", func() {
+			mockCSP.Server.SetNodeReady(false)
+			reconciler.Config.Timeout = time.Minute
+
+			req := reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name: testRebootNode.Name,
+				},
+			}
+
+			before := testutil.ToFloat64(metrics.RebootNodeReadyTimeoutsTotal.WithLabelValues(nodeName))
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			var updatedRebootNode janitordgxcnvidiacomv1alpha1.RebootNode
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(updatedRebootNode.Status.CompletionTime).NotTo(BeNil())
+			Expect(updatedRebootNode.IsNodeReadyTimeout()).To(BeTrue())
+			Expect(testutil.ToFloat64(metrics.RebootNodeReadyTimeoutsTotal.WithLabelValues(nodeName))).
+				To(Equal(before + 1))
+
+			complete, result := janitordgxcnvidiacomv1alpha1.IsRebootComplete(&updatedRebootNode)
+			Expect(complete).To(BeTrue())
+			Expect(result).To(Equal(janitordgxcnvidiacomv1alpha1.RebootResultFailed))
+
+			// The next reconcile sees the completed CR and keeps it from TTL deletion.
+			_, err = reconciler.Reconcile(ctx, req)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: testRebootNode.Name}, &updatedRebootNode)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedRebootNode.GetAnnotations()).To(HaveKeyWithValue(ttl.PreserveAnnotation, "true"))
+		})
+
 		It("should requeue on transient CSP error during node ready check instead of failing", func() {
 			// Configure mock to return transient gRPC error so controller requeues instead of failing
 			mockCSP.Server.SetNodeReadyError(status.Errorf(codes.Unavailable, "transient"))
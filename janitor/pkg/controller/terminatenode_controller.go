@@ -53,6 +53,9 @@ type TerminateNodeReconciler struct {
 	NodeLock      distributedlock.NodeLock
 	LockNamespace string
 
+	// Tunables holds the reloadable settings. When nil, they are read from Config.
+	Tunables *config.TunableStore
+
 	// dialProviderFunc overrides the default gRPC dial behavior.
 	// Used in tests to inject a mock CSP client.
 	dialProviderFunc cspProviderDialFunc
@@ -75,9 +78,17 @@ type TerminateNodeReconciler struct {
 // 4. If node is ready, check for timeout.
 // 5. If signal has not been sent, send it to the CSP instance.
 // 6. Write status updates to the TerminateNode CR.
+func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return reconcileWithTimeout(ctx, "terminatenode", r.tunables().ReconcileTimeout,
+		func(ctx context.Context) (ctrl.Result, error) {
+			return r.reconcile(ctx, req)
+		})
+}
+
+// reconcile performs a single reconciliation of the TerminateNode CR, bounded by Reconcile's timeout.
 //
 //nolint:dupl // Structural duplication with RebootNode is acceptable - different business logic
-func (r *TerminateNodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+func (r *TerminateNodeReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	var terminateNode janitordgxcnvidiacomv1alpha1.TerminateNode
 	if err := r.Get(ctx, req.NamespacedName, &terminateNode); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
@@ -310,7 +321,7 @@ func (r *TerminateNodeReconciler) reconcileHelper(
 
 			result = ctrl.Result{RequeueAfter: 30 * time.Second}
 		} else {
-			if *r.Config.ManualMode {
+			if r.tunables().ManualMode {
 				isManualModeConditionSet := false
 
 				for _, condition := range terminateNode.Status.Conditions {
@@ -446,14 +457,27 @@ func isNodeNotReady(node *corev1.Node) bool {
 	return false
 }
 
+// tunables returns the current reloadable settings of the controller
+func (r *TerminateNodeReconciler) tunables() config.Tunables {
+	if r.Tunables != nil {
+		return r.Tunables.Get()
+	}
+
+	if r.Config == nil {
+		return config.Tunables{}
+	}
+
+	return r.Config.Tunables()
+}
+
 // getTimeout returns the timeout for terminate operations
 func (r *TerminateNodeReconciler) getTimeout() time.Duration {
-	cfg := r.Config
-	if cfg == nil || cfg.Timeout == 0 {
+	timeout := r.tunables().Timeout
+	if timeout == 0 {
 		return 30 * time.Minute // fallback default
 	}
 
-	return cfg.Timeout
+	return timeout
 }
 
 // dialProvider creates a fresh gRPC connection to the CSP provider.
@@ -16,7 +16,10 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -24,8 +27,42 @@ import (
 
 	"github.com/nvidia/nvsentinel/janitor/api/v1alpha1"
 	"github.com/nvidia/nvsentinel/janitor/pkg/config"
+	"github.com/nvidia/nvsentinel/janitor/pkg/metrics"
 )
 
+// requeueAfterReconcileTimeout is the delay before retrying a reconcile that hit its timeout.
+const requeueAfterReconcileTimeout = 5 * time.Second
+
+// reconcileWithTimeout runs reconcile with a context bounded by timeout so a slow API call
+// cannot wedge a worker. A reconcile cut short by the deadline is counted and requeued
+// instead of returning the context error. A non-positive timeout disables the bound.
+func reconcileWithTimeout(
+	ctx context.Context,
+	controllerName string,
+	timeout time.Duration,
+	reconcile func(ctx context.Context) (ctrl.Result, error),
+) (ctrl.Result, error) {
+	if timeout <= 0 {
+		return reconcile(ctx)
+	}
+
+	reconcileCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, err := reconcile(reconcileCtx)
+	if errors.Is(reconcileCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		metrics.ReconcileTimeoutsTotal.WithLabelValues(controllerName).Inc()
+		slog.Warn("Reconcile exceeded timeout, requeueing",
+			"controller", controllerName,
+			"timeout", timeout,
+			"error", err)
+
+		return ctrl.Result{RequeueAfter: requeueAfterReconcileTimeout}, nil
+	}
+
+	return result, err
+}
+
 func ConfigureFieldIndexers(mgr ctrl.Manager, cfg *config.Config) error {
 	managerFieldIndexer := mgr.GetFieldIndexer()
 
@@ -90,6 +90,20 @@ var (
 		Name: "janitor_ttl_deletions_total",
 		Help: "Total number of CRs deleted by the TTL reconciler, labeled by kind.",
 	}, []string{"kind"})
+
+	// ReconcileTimeoutsTotal tracks reconciles cancelled for exceeding the per-reconcile
+	// timeout, labeled by controller.
+	ReconcileTimeoutsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "janitor_reconcile_timeouts_total",
+		Help: "Total number of reconciles cancelled for exceeding the reconcile timeout, labeled by controller.",
+	}, []string{"controller"})
+
+	// RebootNodeReadyTimeoutsTotal tracks reboots after which the node did not return to ready
+	// state within the reboot timeout, labeled by node.
+	RebootNodeReadyTimeoutsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "janitor_reboot_node_ready_timeouts_total",
+		Help: "Total number of reboots after which the node did not return to ready state within the timeout.",
+	}, []string{"node"})
 )
 
 // ActionMetrics provides a centralized interface for recording action metrics
@@ -107,6 +121,8 @@ func NewActionMetrics() *ActionMetrics {
 		GPUResetActiveRequests,
 		GPUResetFailureReasonsTotal,
 		ttlDeletionsTotal,
+		ReconcileTimeoutsTotal,
+		RebootNodeReadyTimeoutsTotal,
 	)
 
 	return &ActionMetrics{}
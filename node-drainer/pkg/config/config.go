@@ -34,6 +34,20 @@ const (
 	ModeDeleteAfterTimeout EvictMode = "DeleteAfterTimeout"
 )
 
+// StatefulSetDrainStrategy controls how pods owned by a StatefulSet are evicted.
+type StatefulSetDrainStrategy string
+
+const (
+	// StatefulSetDrainParallel evicts StatefulSet pods together with all other pods.
+	StatefulSetDrainParallel StatefulSetDrainStrategy = "Parallel"
+	// StatefulSetDrainOrdered evicts StatefulSet pods one at a time, highest ordinal first,
+	// waiting for each to terminate before evicting the next.
+	StatefulSetDrainOrdered StatefulSetDrainStrategy = "Ordered"
+)
+
+// DefaultPreEvictionSignalAnnotationKey is the pod annotation used for the pre-eviction checkpoint signal.
+const DefaultPreEvictionSignalAnnotationKey = "nvsentinel.nvidia.com/checkpoint-requested-at"
+
 type Duration struct {
 	time.Duration
 }
@@ -56,6 +70,55 @@ type CustomDrainConfig struct {
 	StatusConditionStatus string   `toml:"statusConditionStatus"`
 }
 
+// PreEvictionSignalConfig controls the checkpoint signal sent to workloads before they are evicted.
+type PreEvictionSignalConfig struct {
+	Enabled bool `toml:"enabled"`
+	// AnnotationKey is set on each pod to the time the checkpoint was requested
+	AnnotationKey string `toml:"annotationKey"`
+	// LeadTimeSeconds is how long before eviction the signal is sent
+	LeadTimeSeconds int `toml:"leadTimeSeconds"`
+}
+
+// LeadTime returns the configured lead time as a duration.
+func (c PreEvictionSignalConfig) LeadTime() time.Duration {
+	return time.Duration(c.LeadTimeSeconds) * time.Second
+}
+
+// DrainProgressConfig batches the drain progress written to the node Event while pods drain.
+type DrainProgressConfig struct {
+	// UpdateIntervalSeconds is the time after which progress is written again; 0 disables the time bound
+	UpdateIntervalSeconds int `toml:"updateIntervalSeconds"`
+	// UpdateEvictionBatch writes progress once this many pods have left the node; 0 disables the count bound
+	UpdateEvictionBatch int `toml:"updateEvictionBatch"`
+}
+
+// UpdateInterval returns the configured update interval as a duration.
+func (c DrainProgressConfig) UpdateInterval() time.Duration {
+	return time.Duration(c.UpdateIntervalSeconds) * time.Second
+}
+
+// NamespaceEvictionRateLimitConfig bounds how fast the pods of a single namespace are evicted
+// across all nodes being drained at the same time.
+type NamespaceEvictionRateLimitConfig struct {
+	// EvictionsPerSecond is the sustained eviction rate per namespace; 0 disables the limit
+	EvictionsPerSecond float64 `toml:"evictionsPerSecond"`
+	// Burst is the number of evictions a namespace may issue at once before the rate applies
+	Burst int `toml:"burst"`
+}
+
+// EvictionWavesConfig splits the eviction of a namespace's pods on a node into waves.
+type EvictionWavesConfig struct {
+	// WaveSize is the number of pods evicted per wave; 0 evicts all pods at once
+	WaveSize int `toml:"waveSize"`
+	// WaveDelaySeconds is the pause between waves to let evicted pods reschedule
+	WaveDelaySeconds int `toml:"waveDelaySeconds"`
+}
+
+// WaveDelay returns the configured delay between waves as a duration.
+func (c EvictionWavesConfig) WaveDelay() time.Duration {
+	return time.Duration(c.WaveDelaySeconds) * time.Second
+}
+
 type TomlConfig struct {
 	EvictionTimeoutInSeconds  Duration `toml:"evictionTimeoutInSeconds"`
 	SystemNamespaces          string   `toml:"systemNamespaces"`
@@ -65,6 +128,21 @@ type TomlConfig struct {
 	UserNamespaces         []UserNamespace   `toml:"userNamespaces"`
 	CustomDrain            CustomDrainConfig `toml:"customDrain"`
 	PartialDrainEnabled    bool              `toml:"partialDrainEnabled"`
+	// StatefulSetDrainStrategy applies to namespaces drained in Immediate mode
+	StatefulSetDrainStrategy StatefulSetDrainStrategy `toml:"statefulSetDrainStrategy"`
+	// DrainGPUWorkloadsOnly restricts draining to pods requesting GPU resources,
+	// leaving CPU-only pods running on shared nodes
+	DrainGPUWorkloadsOnly bool `toml:"drainGPUWorkloadsOnly"`
+	// StuckEventThresholdMinutes is the time after which an event that is still draining is reported as stuck
+	StuckEventThresholdMinutes int `toml:"stuckEventThresholdMinutes"`
+	// PreEvictionSignal asks workloads to checkpoint a lead time before they are evicted
+	PreEvictionSignal PreEvictionSignalConfig `toml:"preEvictionSignal"`
+	// DrainProgress bounds how often drain progress is written while pods are leaving a node
+	DrainProgress DrainProgressConfig `toml:"drainProgress"`
+	// NamespaceEvictionRateLimit keeps concurrent drains from evicting one tenant's pods too quickly
+	NamespaceEvictionRateLimit NamespaceEvictionRateLimitConfig `toml:"namespaceEvictionRateLimit"`
+	// EvictionWaves evicts large numbers of pods in waves instead of all at once
+	EvictionWaves EvictionWavesConfig `toml:"evictionWaves"`
 }
 
 func (d *Duration) UnmarshalTOML(text any) error {
@@ -137,6 +215,33 @@ func validateCustomDrainConfig(config *TomlConfig) error {
 	return nil
 }
 
+func validatePreEvictionSignalConfig(config *TomlConfig) error {
+	if !config.PreEvictionSignal.Enabled {
+		return nil
+	}
+
+	if config.PreEvictionSignal.AnnotationKey == "" {
+		config.PreEvictionSignal.AnnotationKey = DefaultPreEvictionSignalAnnotationKey
+	}
+
+	if config.PreEvictionSignal.LeadTimeSeconds == 0 {
+		config.PreEvictionSignal.LeadTimeSeconds = 300 // Default: 5 minutes
+	}
+
+	if config.PreEvictionSignal.LeadTimeSeconds <= 0 {
+		return fmt.Errorf("preEvictionSignal.leadTimeSeconds must be a positive integer")
+	}
+
+	// The signal for DeleteAfterTimeout namespaces is sent lead time before the force delete deadline,
+	// so the lead time has to fit inside the drain timeout.
+	if config.PreEvictionSignal.LeadTime() >= time.Duration(config.DeleteAfterTimeoutMinutes)*time.Minute {
+		return fmt.Errorf("preEvictionSignal.leadTimeSeconds (%d) must be less than deleteAfterTimeoutMinutes (%d)",
+			config.PreEvictionSignal.LeadTimeSeconds, config.DeleteAfterTimeoutMinutes)
+	}
+
+	return nil
+}
+
 func validateAndSetDefaults(config *TomlConfig) (*TomlConfig, error) {
 	if err := validateCustomDrainConfig(config); err != nil {
 		return nil, err
@@ -158,6 +263,41 @@ func validateAndSetDefaults(config *TomlConfig) (*TomlConfig, error) {
 		return nil, fmt.Errorf("notReadyTimeoutMinutes must be a positive integer")
 	}
 
+	if config.StuckEventThresholdMinutes == 0 {
+		config.StuckEventThresholdMinutes = 60 // Default: 60 minutes
+	}
+
+	if config.StuckEventThresholdMinutes <= 0 {
+		return nil, fmt.Errorf("stuckEventThresholdMinutes must be a positive integer")
+	}
+
+	if err := validatePreEvictionSignalConfig(config); err != nil {
+		return nil, err
+	}
+
+	if config.DrainProgress.UpdateIntervalSeconds < 0 || config.DrainProgress.UpdateEvictionBatch < 0 {
+		return nil, fmt.Errorf("drainProgress.updateIntervalSeconds and drainProgress.updateEvictionBatch " +
+			"must not be negative")
+	}
+
+	if config.NamespaceEvictionRateLimit.EvictionsPerSecond < 0 || config.NamespaceEvictionRateLimit.Burst < 0 {
+		return nil, fmt.Errorf("namespaceEvictionRateLimit.evictionsPerSecond and namespaceEvictionRateLimit.burst " +
+			"must not be negative")
+	}
+
+	if config.EvictionWaves.WaveSize < 0 || config.EvictionWaves.WaveDelaySeconds < 0 {
+		return nil, fmt.Errorf("evictionWaves.waveSize and evictionWaves.waveDelaySeconds must not be negative")
+	}
+
+	switch config.StatefulSetDrainStrategy {
+	case "":
+		config.StatefulSetDrainStrategy = StatefulSetDrainParallel
+	case StatefulSetDrainParallel, StatefulSetDrainOrdered:
+	default:
+		return nil, fmt.Errorf("invalid statefulSetDrainStrategy %q: must be %q or %q",
+			config.StatefulSetDrainStrategy, StatefulSetDrainParallel, StatefulSetDrainOrdered)
+	}
+
 	return config, nil
 }
 
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"golang.org/x/time/rate"
 	v1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -55,6 +56,36 @@ type Informers struct {
 	notReadyTimeoutMinutes *int
 	dryRunMode             []string
 	namespace              string
+
+	// orderedStatefulSetEviction evicts StatefulSet pods one at a time, highest ordinal first.
+	orderedStatefulSetEviction bool
+
+	// gpuWorkloadsOnly limits evictable pods to those requesting GPU resources.
+	gpuWorkloadsOnly bool
+
+	// preEvictionSignalKey is the pod annotation used to request a checkpoint before eviction.
+	// An empty key disables the signal.
+	preEvictionSignalKey  string
+	preEvictionSignalLead time.Duration
+
+	// progressInterval and progressBatchSize bound how often drain progress is written
+	// to the node Event; progress holds the last write per node and reason.
+	progressInterval  time.Duration
+	progressBatchSize int
+	progressMu        sync.Mutex
+	progress          map[string]map[string]drainProgress
+
+	// namespaceEvictionRate and namespaceEvictionBurst bound evictions per namespace across all
+	// drains; namespaceLimiters holds the token bucket of each namespace seen so far.
+	namespaceEvictionRate  rate.Limit
+	namespaceEvictionBurst int
+	namespaceLimitersMu    sync.Mutex
+	namespaceLimiters      map[string]*rate.Limiter
+
+	// evictionWaveSize and evictionWaveDelay split the eviction of a namespace's pods on a node
+	// into waves; a wave size of zero evicts all pods at once.
+	evictionWaveSize  int
+	evictionWaveDelay time.Duration
 }
 
 func NewInformers(clientset kubernetes.Interface, resyncPeriod time.Duration,
@@ -106,9 +137,26 @@ func NewInformers(clientset kubernetes.Interface, resyncPeriod time.Duration,
 		notReadyTimeoutMinutes: notReadyTimeoutMinutes,
 		dryRunMode:             dryRunMode,
 		namespace:              metav1.NamespaceDefault,
+		progress:               make(map[string]map[string]drainProgress),
 	}, nil
 }
 
+// SetOrderedStatefulSetEviction enables evicting StatefulSet pods in descending ordinal order.
+func (i *Informers) SetOrderedStatefulSetEviction(enabled bool) {
+	i.orderedStatefulSetEviction = enabled
+}
+
+// SetGPUWorkloadsOnly restricts draining to pods that request GPU resources.
+func (i *Informers) SetGPUWorkloadsOnly(enabled bool) {
+	i.gpuWorkloadsOnly = enabled
+}
+
+// SetPreEvictionSignal annotates pods with annotationKey leadTime before they are evicted.
+func (i *Informers) SetPreEvictionSignal(annotationKey string, leadTime time.Duration) {
+	i.preEvictionSignalKey = annotationKey
+	i.preEvictionSignalLead = leadTime
+}
+
 func (i *Informers) HasSynced() bool {
 	return i.podInformer.HasSynced() && i.eventInformer.HasSynced() && i.nodeInformer.HasSynced()
 }
@@ -189,6 +237,10 @@ func (i *Informers) FindEvictablePodsInNamespaceAndNode(namespace, nodeName stri
 
 	pods = i.filterEvictablePods(pods)
 
+	if i.gpuWorkloadsOnly {
+		pods = filterGPUPods(pods)
+	}
+
 	pods, err = i.filterPodsUsingEntity(pods, partialDrainEntity, nodeName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to filter pods using entity: %w", err)
@@ -323,6 +375,28 @@ func areContainersRequestingDevice(containers []v1.Container, resourceNames []st
 	return false
 }
 
+// filterGPUPods returns the pods whose containers or init containers request GPU resources.
+func filterGPUPods(pods []*v1.Pod) []*v1.Pod {
+	gpuResourceNames := model.EntityTypeToResourceNames["GPU_UUID"]
+	filteredPods := []*v1.Pod{}
+
+	for _, pod := range pods {
+		if areContainersRequestingDevice(pod.Spec.Containers, gpuResourceNames) ||
+			areContainersRequestingDevice(pod.Spec.InitContainers, gpuResourceNames) {
+			filteredPods = append(filteredPods, pod)
+
+			continue
+		}
+
+		slog.Debug("Skipping pod without GPU resources in GPU-only drain mode",
+			"pod", pod.Name,
+			"namespace", pod.Namespace,
+			"node", pod.Spec.NodeName)
+	}
+
+	return filteredPods
+}
+
 func (i *Informers) filterEvictablePods(pods []*v1.Pod) []*v1.Pod {
 	filteredPods := []*v1.Pod{}
 
@@ -437,6 +511,18 @@ func (i *Informers) EvictAllPodsInImmediateMode(ctx context.Context,
 		return nil
 	}
 
+	pods = selectPodsForEviction(pods, i.orderedStatefulSetEviction)
+
+	pods, err = i.signalPodsBeforeEviction(ctx, nodeName, pods)
+	if err != nil {
+		return fmt.Errorf("failed to signal pods in namespace %s on node %s before eviction: %w",
+			namespace, nodeName, err)
+	}
+
+	if len(pods) == 0 {
+		return nil
+	}
+
 	err = i.evictPodsInNamespaceAndNode(ctx, namespace, timeout, pods)
 	if err != nil {
 		slog.ErrorContext(ctx, "Failed to evict pods in namespace on node",
@@ -451,6 +537,33 @@ func (i *Informers) EvictAllPodsInImmediateMode(ctx context.Context,
 }
 
 func (i *Informers) evictPodsInNamespaceAndNode(ctx context.Context,
+	namespace string, timeout time.Duration, pods []*v1.Pod) error {
+	waves := splitIntoWaves(pods, i.evictionWaveSize)
+
+	for n, wave := range waves {
+		if n > 0 {
+			if err := waitForNextWave(ctx, i.evictionWaveDelay); err != nil {
+				return err
+			}
+		}
+
+		if err := i.evictPodWave(ctx, namespace, timeout, wave); err != nil {
+			if n < len(waves)-1 {
+				slog.WarnContext(ctx, "Eviction wave failed, deferring remaining waves to the next drain pass",
+					"namespace", namespace,
+					"wave", n+1,
+					"waves", len(waves))
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// evictPodWave sends eviction requests for all pods of a wave concurrently.
+func (i *Informers) evictPodWave(ctx context.Context,
 	namespace string, timeout time.Duration, pods []*v1.Pod) error {
 	var wg sync.WaitGroup
 
@@ -464,7 +577,11 @@ func (i *Informers) evictPodsInNamespaceAndNode(ctx context.Context,
 		go func(ctx context.Context, pod *v1.Pod, timeout time.Duration) {
 			defer wg.Done()
 
-			err := i.sendEvictionRequestForPod(ctx, namespace, timeout, pod)
+			err := i.waitForNamespaceEviction(ctx, pod.Namespace)
+			if err == nil {
+				err = i.sendEvictionRequestForPod(ctx, namespace, timeout, pod)
+			}
+
 			if err != nil {
 				if errors.IsNotFound(err) {
 					slog.InfoContext(ctx, "Pod already evicted from namespace on node",
@@ -634,10 +751,19 @@ func (i *Informers) DeletePodsAfterTimeout(ctx context.Context, nodeName string,
 	if evicted {
 		slog.InfoContext(ctx, "All pods on node have been deleted", "node", nodeName)
 		metrics.NodeDrainTimeout.WithLabelValues(nodeName).Set(0)
+		i.ClearDrainProgress(nodeName)
 
 		return nil
 	}
 
+	if i.preEvictionSignalEnabled() && drainTimeout <= i.preEvictionSignalLead {
+		if _, err := i.signalPodsBeforeEviction(ctx, nodeName, remainingPods); err != nil {
+			slog.ErrorContext(ctx, "Failed to signal pods before force deletion",
+				"node", nodeName,
+				"error", err)
+		}
+	}
+
 	if timeoutReached {
 		slog.InfoContext(ctx, "Timeout reached for node, force deleting remaining pods",
 			"node", nodeName,
@@ -678,7 +804,7 @@ func (i *Informers) DeletePodsAfterTimeout(ctx context.Context, nodeName string,
 
 	reason := "WaitingBeforeForceDelete"
 
-	if err := i.UpdateNodeEvent(ctx, nodeName, reason, message); err != nil {
+	if err := i.UpdateNodeDrainProgress(ctx, nodeName, reason, message, len(remainingPods)); err != nil {
 		slog.ErrorContext(ctx, "Failed to update node event",
 			"node", nodeName,
 			"error", err)
@@ -914,14 +1040,21 @@ func (i *Informers) CheckIfAllPodsAreEvictedInImmediateMode(ctx context.Context,
 		return allEvicted
 	}
 
-	remainingPodNames := make([]string, 0, len(remainingPods))
-	for _, pod := range remainingPods {
-		remainingPodNames = append(remainingPodNames, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+	remainingPodNames, statefulSetPodNames := splitStatefulSetPodNames(remainingPods)
+
+	message := immediateEvictionMessage(remainingPodNames, statefulSetPodNames, i.orderedStatefulSetEviction)
+	if err := i.UpdateNodeDrainProgress(ctx, nodeName, "AwaitingPodEviction", message,
+		len(remainingPods)); err != nil {
+		slog.ErrorContext(ctx, "Failed to update node event",
+			"node", nodeName,
+			"error", err)
 	}
 
 	slog.InfoContext(ctx, "Pods still present on node, will retry",
 		"node", nodeName,
-		"pods", remainingPodNames)
+		"pods", remainingPodNames,
+		"statefulSetPods", statefulSetPodNames,
+		"orderedStatefulSetEviction", i.orderedStatefulSetEviction)
 
 	return false
 }
@@ -0,0 +1,122 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+func (i *Informers) preEvictionSignalEnabled() bool {
+	return i.preEvictionSignalKey != "" && i.preEvictionSignalLead > 0
+}
+
+// signalPodsBeforeEviction annotates pods that have not been signalled yet with the current time
+// and returns the pods whose lead time has elapsed and can be evicted now. When the signal is
+// disabled all pods are returned unchanged.
+func (i *Informers) signalPodsBeforeEviction(ctx context.Context, nodeName string,
+	pods []*v1.Pod) ([]*v1.Pod, error) {
+	if !i.preEvictionSignalEnabled() {
+		return pods, nil
+	}
+
+	now := time.Now()
+	ready := make([]*v1.Pod, 0, len(pods))
+
+	for _, pod := range pods {
+		signalledAt, ok := i.preEvictionSignalTime(pod)
+		if !ok {
+			if err := i.annotatePreEvictionSignal(ctx, pod, now); err != nil {
+				return nil, err
+			}
+
+			metrics.PreEvictionSignalsSent.WithLabelValues(nodeName).Inc()
+
+			slog.InfoContext(ctx, "Requested checkpoint from pod before eviction",
+				"pod", pod.Name,
+				"namespace", pod.Namespace,
+				"node", nodeName,
+				"leadTime", i.preEvictionSignalLead)
+
+			continue
+		}
+
+		if now.Sub(signalledAt) < i.preEvictionSignalLead {
+			slog.DebugContext(ctx, "Waiting for pre-eviction lead time before evicting pod",
+				"pod", pod.Name,
+				"namespace", pod.Namespace,
+				"remaining", i.preEvictionSignalLead-now.Sub(signalledAt))
+
+			continue
+		}
+
+		ready = append(ready, pod)
+	}
+
+	return ready, nil
+}
+
+// preEvictionSignalTime returns when the pod was signalled, treating an unparsable value as
+// signalled long ago so a corrupted annotation cannot block eviction.
+func (i *Informers) preEvictionSignalTime(pod *v1.Pod) (time.Time, bool) {
+	value, ok := pod.Annotations[i.preEvictionSignalKey]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	signalledAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		slog.Warn("Ignoring invalid pre-eviction signal annotation",
+			"pod", pod.Name,
+			"namespace", pod.Namespace,
+			"value", value,
+			"error", err)
+
+		return time.Time{}, true
+	}
+
+	return signalledAt, true
+}
+
+func (i *Informers) annotatePreEvictionSignal(ctx context.Context, pod *v1.Pod, now time.Time) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				i.preEvictionSignalKey: now.UTC().Format(time.RFC3339),
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal pre-eviction signal patch: %w", err)
+	}
+
+	_, err = i.clientset.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, types.MergePatchType, patch,
+		metav1.PatchOptions{DryRun: i.dryRunMode})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to annotate pod %s/%s with pre-eviction signal: %w", pod.Namespace, pod.Name, err)
+	}
+
+	return nil
+}
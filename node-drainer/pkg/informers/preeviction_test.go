@@ -0,0 +1,148 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+)
+
+const (
+	testSignalKey  = "nvsentinel.nvidia.com/checkpoint-requested-at"
+	testSignalLead = 10 * time.Minute
+)
+
+func newPreEvictionTestInformers(t *testing.T, pod *v1.Pod) (*Informers, *fake.Clientset) {
+	t.Helper()
+
+	clientset := fake.NewSimpleClientset(pod)
+	clientset.PrependReactor("create", "pods",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return action.GetSubresource() == "eviction", nil, nil
+		})
+
+	i, err := NewInformers(clientset, 0, nil, false)
+	require.NoError(t, err)
+
+	i.SetPreEvictionSignal(testSignalKey, testSignalLead)
+	require.NoError(t, i.podInformer.GetIndexer().Add(pod))
+
+	return i, clientset
+}
+
+func newTrainingPod(annotations map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "trainer-0",
+			Namespace:   "training",
+			Annotations: annotations,
+		},
+		Spec: v1.PodSpec{NodeName: "node-1"},
+	}
+}
+
+// setSignalledAt replaces the cached pod with one signalled the given duration ago.
+func setSignalledAt(t *testing.T, i *Informers, ago time.Duration) {
+	t.Helper()
+
+	pod := newTrainingPod(map[string]string{
+		testSignalKey: time.Now().Add(-ago).UTC().Format(time.RFC3339),
+	})
+	require.NoError(t, i.podInformer.GetIndexer().Update(pod))
+}
+
+func countActions(clientset *fake.Clientset, verb, subresource string) int {
+	count := 0
+
+	for _, action := range clientset.Actions() {
+		if action.GetVerb() == verb && action.GetResource().Resource == "pods" &&
+			action.GetSubresource() == subresource {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestEvictAllPodsInImmediateMode_PreEvictionSignal(t *testing.T) {
+	ctx := context.Background()
+	i, clientset := newPreEvictionTestInformers(t, newTrainingPod(nil))
+
+	require.NoError(t, i.EvictAllPodsInImmediateMode(ctx, "training", "node-1", time.Minute, nil))
+
+	assert.Equal(t, 1, countActions(clientset, "patch", ""), "pod should be signalled first")
+	assert.Zero(t, countActions(clientset, "create", "eviction"), "eviction must wait for the lead time")
+
+	pod, err := clientset.CoreV1().Pods("training").Get(ctx, "trainer-0", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	signalledAt, err := time.Parse(time.RFC3339, pod.Annotations[testSignalKey])
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), signalledAt, time.Minute)
+
+	// Halfway through the lead time the pod is neither signalled again nor evicted.
+	setSignalledAt(t, i, testSignalLead/2)
+	require.NoError(t, i.EvictAllPodsInImmediateMode(ctx, "training", "node-1", time.Minute, nil))
+
+	assert.Equal(t, 1, countActions(clientset, "patch", ""))
+	assert.Zero(t, countActions(clientset, "create", "eviction"))
+
+	// Once the lead time has elapsed, eviction proceeds.
+	setSignalledAt(t, i, testSignalLead+time.Second)
+	require.NoError(t, i.EvictAllPodsInImmediateMode(ctx, "training", "node-1", time.Minute, nil))
+
+	assert.Equal(t, 1, countActions(clientset, "patch", ""))
+	assert.Equal(t, 1, countActions(clientset, "create", "eviction"))
+}
+
+func TestDeletePodsAfterTimeout_PreEvictionSignal(t *testing.T) {
+	ctx := context.Background()
+	i, clientset := newPreEvictionTestInformers(t, newTrainingPod(nil))
+
+	const timeoutMinutes = 60
+
+	// More than the lead time remains before force deletion: no signal yet.
+	event := &model.HealthEventWithStatus{CreatedAt: time.Now().Add(-30 * time.Minute)}
+	require.Error(t, i.DeletePodsAfterTimeout(ctx, "node-1", []string{"training"}, timeoutMinutes, event, nil))
+
+	assert.Zero(t, countActions(clientset, "patch", ""))
+
+	// Within the lead time of the deadline the pod is signalled but not deleted.
+	event.CreatedAt = time.Now().Add(-55 * time.Minute)
+	require.Error(t, i.DeletePodsAfterTimeout(ctx, "node-1", []string{"training"}, timeoutMinutes, event, nil))
+
+	assert.Equal(t, 1, countActions(clientset, "patch", ""))
+	assert.Zero(t, countActions(clientset, "delete", ""))
+
+	// At the deadline the pod is force deleted.
+	setSignalledAt(t, i, 5*time.Minute)
+
+	event.CreatedAt = time.Now().Add(-timeoutMinutes * time.Minute)
+	require.Error(t, i.DeletePodsAfterTimeout(ctx, "node-1", []string{"training"}, timeoutMinutes, event, nil))
+
+	assert.Equal(t, 1, countActions(clientset, "patch", ""), "already signalled pod is not signalled again")
+	assert.Equal(t, 1, countActions(clientset, "delete", ""))
+}
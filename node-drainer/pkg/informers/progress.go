@@ -0,0 +1,91 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"context"
+	"time"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+// drainProgress records the last progress update written for a node and reason.
+type drainProgress struct {
+	writtenAt time.Time
+	remaining int
+}
+
+// SetDrainProgressBatching limits how often drain progress is written to the node Event. A
+// progress update is written once interval has passed or at least batchSize pods have left the
+// node since the previous write. Zero values disable the respective bound; with both disabled,
+// every update is written.
+func (i *Informers) SetDrainProgressBatching(interval time.Duration, batchSize int) {
+	i.progressInterval = interval
+	i.progressBatchSize = batchSize
+}
+
+// UpdateNodeDrainProgress reports the pods still draining on a node through the node Event,
+// skipping the write while neither batching bound has been reached. Progress is only recorded
+// once the write succeeds, so a failed write is retried on the next update.
+func (i *Informers) UpdateNodeDrainProgress(ctx context.Context, nodeName, reason, message string,
+	remaining int) error {
+	now := time.Now()
+
+	i.progressMu.Lock()
+	last, ok := i.progress[nodeName][reason]
+	i.progressMu.Unlock()
+
+	if ok && !i.progressDue(last, now, remaining) {
+		metrics.DrainProgressUpdatesSkipped.WithLabelValues(nodeName).Inc()
+
+		return nil
+	}
+
+	if err := i.UpdateNodeEvent(ctx, nodeName, reason, message); err != nil {
+		return err
+	}
+
+	i.progressMu.Lock()
+	defer i.progressMu.Unlock()
+
+	if i.progress[nodeName] == nil {
+		i.progress[nodeName] = make(map[string]drainProgress)
+	}
+
+	i.progress[nodeName][reason] = drainProgress{writtenAt: now, remaining: remaining}
+
+	return nil
+}
+
+// ClearDrainProgress forgets the progress state of a node once its drain has finished or was
+// cancelled.
+func (i *Informers) ClearDrainProgress(nodeName string) {
+	i.progressMu.Lock()
+	defer i.progressMu.Unlock()
+
+	delete(i.progress, nodeName)
+}
+
+func (i *Informers) progressDue(last drainProgress, now time.Time, remaining int) bool {
+	if i.progressInterval <= 0 && i.progressBatchSize <= 0 {
+		return true
+	}
+
+	if i.progressInterval > 0 && now.Sub(last.writtenAt) >= i.progressInterval {
+		return true
+	}
+
+	return i.progressBatchSize > 0 && last.remaining-remaining >= i.progressBatchSize
+}
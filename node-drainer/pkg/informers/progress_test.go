@@ -0,0 +1,142 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+const progressReason = "AwaitingPodCompletion"
+
+func newProgressTestInformers(t *testing.T, interval time.Duration, batchSize int) (*Informers, *fake.Clientset) {
+	t.Helper()
+
+	clientset := fake.NewSimpleClientset()
+
+	// The fake clientset does not honour generateName, so name created events the way the API server would.
+	generated := 0
+	clientset.PrependReactor("create", "events",
+		func(action clienttesting.Action) (bool, runtime.Object, error) {
+			event := action.(clienttesting.CreateAction).GetObject().(*v1.Event)
+			if event.Name == "" && event.GenerateName != "" {
+				generated++
+				event.Name = fmt.Sprintf("%s%d", event.GenerateName, generated)
+			}
+
+			return false, nil, nil
+		})
+
+	i, err := NewInformers(clientset, 0, nil, false)
+	require.NoError(t, err)
+
+	i.SetDrainProgressBatching(interval, batchSize)
+	require.NoError(t, i.nodeInformer.GetIndexer().Add(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}))
+
+	return i, clientset
+}
+
+func countEventWrites(clientset *fake.Clientset) int {
+	count := 0
+
+	for _, action := range clientset.Actions() {
+		if action.GetResource().Resource == "events" &&
+			(action.GetVerb() == "create" || action.GetVerb() == "update") {
+			count++
+		}
+	}
+
+	return count
+}
+
+// drainOneByOne reports progress as pods leave the node one at a time.
+func drainOneByOne(t *testing.T, i *Informers, podCount int) {
+	t.Helper()
+
+	for remaining := podCount; remaining > 0; remaining-- {
+		message := fmt.Sprintf("Waiting for %d pods to finish", remaining)
+		require.NoError(t, i.UpdateNodeDrainProgress(context.Background(), "node-1", progressReason, message, remaining))
+	}
+}
+
+func TestUpdateNodeDrainProgress_BatchesWrites(t *testing.T) {
+	const (
+		podCount  = 50
+		batchSize = 10
+	)
+
+	i, clientset := newProgressTestInformers(t, time.Hour, batchSize)
+
+	drainOneByOne(t, i, podCount)
+
+	writes := countEventWrites(clientset)
+	assert.LessOrEqual(t, writes, podCount/batchSize+1, "progress writes must be bounded by the batch size")
+	assert.Equal(t, 5, writes, "first report plus one write per batch of departed pods")
+}
+
+func TestUpdateNodeDrainProgress_Unbatched(t *testing.T) {
+	i, clientset := newProgressTestInformers(t, 0, 0)
+
+	drainOneByOne(t, i, 20)
+
+	assert.Equal(t, 20, countEventWrites(clientset))
+}
+
+func TestUpdateNodeDrainProgress_IntervalElapsed(t *testing.T) {
+	ctx := context.Background()
+	i, clientset := newProgressTestInformers(t, time.Minute, 0)
+
+	require.NoError(t, i.UpdateNodeDrainProgress(ctx, "node-1", progressReason, "3 pods left", 3))
+	require.NoError(t, i.UpdateNodeDrainProgress(ctx, "node-1", progressReason, "2 pods left", 2))
+	assert.Equal(t, 1, countEventWrites(clientset), "update within the interval should be held back")
+
+	i.progress["node-1"][progressReason] = drainProgress{writtenAt: time.Now().Add(-2 * time.Minute), remaining: 2}
+
+	require.NoError(t, i.UpdateNodeDrainProgress(ctx, "node-1", progressReason, "1 pod left", 1))
+	assert.Equal(t, 2, countEventWrites(clientset), "update after the interval should be written")
+
+	i.ClearDrainProgress("node-1")
+	require.NoError(t, i.UpdateNodeDrainProgress(ctx, "node-1", progressReason, "4 pods left", 4))
+	assert.Equal(t, 3, countEventWrites(clientset), "a new drain should report immediately")
+}
+
+func TestUpdateNodeDrainProgress_FailedWriteIsRetried(t *testing.T) {
+	ctx := context.Background()
+	i, clientset := newProgressTestInformers(t, time.Hour, 10)
+
+	clientset.PrependReactor("create", "events",
+		func(action clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("api server unavailable")
+		})
+
+	require.Error(t, i.UpdateNodeDrainProgress(ctx, "node-1", progressReason, "3 pods left", 3))
+	assert.NotContains(t, i.progress["node-1"], progressReason, "a failed write must not be recorded as progress")
+
+	clientset.ReactionChain = clientset.ReactionChain[1:]
+
+	require.NoError(t, i.UpdateNodeDrainProgress(ctx, "node-1", progressReason, "2 pods left", 2))
+	assert.Equal(t, 2, i.progress["node-1"][progressReason].remaining)
+}
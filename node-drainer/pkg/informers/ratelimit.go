@@ -0,0 +1,69 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/time/rate"
+
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+// SetNamespaceEvictionRateLimit bounds how fast pods of any single namespace are evicted, across all
+// nodes being drained concurrently. Each namespace gets its own token bucket refilled at
+// evictionsPerSecond and holding up to burst evictions. A rate of zero disables the limit.
+func (i *Informers) SetNamespaceEvictionRateLimit(evictionsPerSecond float64, burst int) {
+	i.namespaceLimitersMu.Lock()
+	defer i.namespaceLimitersMu.Unlock()
+
+	i.namespaceEvictionRate = rate.Limit(evictionsPerSecond)
+	i.namespaceEvictionBurst = max(burst, 1)
+	i.namespaceLimiters = make(map[string]*rate.Limiter)
+}
+
+// waitForNamespaceEviction blocks until the rate limit of the namespace allows another eviction.
+func (i *Informers) waitForNamespaceEviction(ctx context.Context, namespace string) error {
+	limiter := i.namespaceLimiter(namespace)
+	if limiter == nil || limiter.Allow() {
+		return nil
+	}
+
+	metrics.NamespaceEvictionsThrottled.WithLabelValues(namespace).Inc()
+
+	if err := limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("waiting for eviction rate limit of namespace %s: %w", namespace, err)
+	}
+
+	return nil
+}
+
+func (i *Informers) namespaceLimiter(namespace string) *rate.Limiter {
+	i.namespaceLimitersMu.Lock()
+	defer i.namespaceLimitersMu.Unlock()
+
+	if i.namespaceEvictionRate <= 0 {
+		return nil
+	}
+
+	limiter, ok := i.namespaceLimiters[namespace]
+	if !ok {
+		limiter = rate.NewLimiter(i.namespaceEvictionRate, i.namespaceEvictionBurst)
+		i.namespaceLimiters[namespace] = limiter
+	}
+
+	return limiter
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestNamespaceEvictionRateLimit(t *testing.T) {
+	const evictionInterval = 100 * time.Millisecond
+
+	var (
+		mu          sync.Mutex
+		evictedAt   = map[string][]time.Time{}
+		clientset   = fake.NewSimpleClientset()
+		podsPerNode = map[string]map[string]int{
+			"tenant-a": {"node-1": 2, "node-2": 2},
+			"tenant-b": {"node-1": 4},
+		}
+	)
+
+	clientset.PrependReactor("create", "pods",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "eviction" {
+				return false, nil, nil
+			}
+
+			mu.Lock()
+			evictedAt[action.GetNamespace()] = append(evictedAt[action.GetNamespace()], time.Now())
+			mu.Unlock()
+
+			return true, nil, nil
+		})
+
+	i, err := NewInformers(clientset, 0, nil, false)
+	require.NoError(t, err)
+
+	i.SetNamespaceEvictionRateLimit(float64(time.Second/evictionInterval), 1)
+
+	for namespace, nodes := range podsPerNode {
+		for nodeName, count := range nodes {
+			for n := range count {
+				require.NoError(t, i.podInformer.GetIndexer().Add(&v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-%s-%d", namespace, nodeName, n),
+						Namespace: namespace,
+					},
+					Spec: v1.PodSpec{NodeName: nodeName},
+				}))
+			}
+		}
+	}
+
+	// Drain both nodes concurrently, so tenant-a shares its rate limit across two drains.
+	var wg sync.WaitGroup
+
+	for namespace, nodes := range podsPerNode {
+		for nodeName := range nodes {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				assert.NoError(t, i.EvictAllPodsInImmediateMode(context.Background(),
+					namespace, nodeName, time.Minute, nil))
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	require.Len(t, evictedAt["tenant-a"], 4)
+	require.Len(t, evictedAt["tenant-b"], 4)
+
+	for namespace, times := range evictedAt {
+		for n := 1; n < len(times); n++ {
+			assert.GreaterOrEqual(t, times[n].Sub(times[n-1]), evictionInterval*8/10,
+				"evictions in %s are not rate limited", namespace)
+		}
+	}
+
+	// Each namespace has its own bucket, so neither waits for the other's evictions.
+	firstGap := evictedAt["tenant-a"][0].Sub(evictedAt["tenant-b"][0]).Abs()
+	assert.Less(t, firstGap, evictionInterval/2)
+}
+
+func TestNamespaceEvictionRateLimitDisabled(t *testing.T) {
+	i, err := NewInformers(fake.NewSimpleClientset(), 0, nil, false)
+	require.NoError(t, err)
+
+	assert.Nil(t, i.namespaceLimiter("tenant-a"))
+
+	i.SetNamespaceEvictionRateLimit(0, 5)
+	assert.Nil(t, i.namespaceLimiter("tenant-a"))
+	assert.NoError(t, i.waitForNamespaceEviction(context.Background(), "tenant-a"))
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// statefulSetOwner returns the name of the StatefulSet owning the pod, if any.
+func statefulSetOwner(pod *v1.Pod) (string, bool) {
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind == "StatefulSet" {
+			return owner.Name, true
+		}
+	}
+
+	return "", false
+}
+
+// splitStatefulSetPodNames returns the sorted namespace/name of the pods not owned by a
+// StatefulSet and of those that are.
+func splitStatefulSetPodNames(pods []*v1.Pod) (podNames, statefulSetPodNames []string) {
+	podNames = []string{}
+	statefulSetPodNames = []string{}
+
+	for _, pod := range pods {
+		name := fmt.Sprintf("%s/%s", pod.Namespace, pod.Name)
+		if _, ok := statefulSetOwner(pod); ok {
+			statefulSetPodNames = append(statefulSetPodNames, name)
+			continue
+		}
+
+		podNames = append(podNames, name)
+	}
+
+	sort.Strings(podNames)
+	sort.Strings(statefulSetPodNames)
+
+	return podNames, statefulSetPodNames
+}
+
+// immediateEvictionMessage describes the pods an immediate drain is still waiting for. StatefulSet
+// pods are listed separately since, with ordered eviction, they leave one at a time and are
+// usually why the drain takes longer.
+func immediateEvictionMessage(podNames, statefulSetPodNames []string, ordered bool) string {
+	parts := make([]string, 0, 2)
+
+	if len(podNames) > 0 {
+		parts = append(parts, fmt.Sprintf("Waiting for following pods to be evicted: %v", podNames))
+	}
+
+	if len(statefulSetPodNames) > 0 {
+		order := "evicted in parallel"
+		if ordered {
+			order = "evicted one at a time, highest ordinal first"
+		}
+
+		parts = append(parts, fmt.Sprintf("Waiting for following StatefulSet pods (%s): %v",
+			order, statefulSetPodNames))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// statefulSetOrdinal returns the ordinal encoded in a StatefulSet pod name (<set>-<ordinal>),
+// or -1 if the name does not follow that pattern.
+func statefulSetOrdinal(pod *v1.Pod, setName string) int {
+	ordinal, err := strconv.Atoi(strings.TrimPrefix(pod.Name, setName+"-"))
+	if err != nil || ordinal < 0 {
+		return -1
+	}
+
+	return ordinal
+}
+
+// selectPodsForEviction returns the pods to evict in this pass. Pods not owned by a
+// StatefulSet are always returned. With ordered StatefulSet eviction, only the
+// highest-ordinal pod of each StatefulSet is returned, and none while another pod of
+// that StatefulSet is still terminating, so replicas leave one at a time from the
+// highest ordinal down across requeues.
+func selectPodsForEviction(pods []*v1.Pod, orderedStatefulSets bool) []*v1.Pod {
+	if !orderedStatefulSets {
+		return pods
+	}
+
+	selected := make([]*v1.Pod, 0, len(pods))
+	highest := make(map[string]*v1.Pod)
+	terminating := make(map[string]bool)
+
+	var setOrder []string
+
+	for _, pod := range pods {
+		setName, ok := statefulSetOwner(pod)
+		if !ok {
+			selected = append(selected, pod)
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", pod.Namespace, setName)
+
+		if pod.DeletionTimestamp != nil {
+			terminating[key] = true
+			continue
+		}
+
+		current, seen := highest[key]
+		if !seen {
+			setOrder = append(setOrder, key)
+		}
+
+		if !seen || statefulSetOrdinal(pod, setName) > statefulSetOrdinal(current, setName) {
+			highest[key] = pod
+		}
+	}
+
+	for _, key := range setOrder {
+		if terminating[key] {
+			continue
+		}
+
+		selected = append(selected, highest[key])
+	}
+
+	return selected
+}
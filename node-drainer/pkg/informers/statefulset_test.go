@@ -0,0 +1,166 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func makeStatefulSetPod(setName string, ordinal int) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      setName + "-" + strconv.Itoa(ordinal),
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "StatefulSet", Name: setName},
+			},
+		},
+	}
+}
+
+func podNames(pods []*v1.Pod) []string {
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+
+	return names
+}
+
+func TestSelectPodsForEviction_OrderedStatefulSet(t *testing.T) {
+	standalone := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: "default"}}
+	remaining := []*v1.Pod{
+		makeStatefulSetPod("db", 0),
+		makeStatefulSetPod("db", 1),
+		makeStatefulSetPod("db", 2),
+		standalone,
+	}
+
+	var evictionOrder []string
+
+	// Each pass mirrors one reconcile: the selected StatefulSet pod is evicted and
+	// then blocks the next one while terminating, until it is gone from the node.
+	for pass := 0; pass < 10 && len(remaining) > 0; pass++ {
+		selected := selectPodsForEviction(remaining, true)
+
+		var next []*v1.Pod
+
+		for _, pod := range remaining {
+			switch {
+			case pod.DeletionTimestamp != nil:
+				// Terminated since the previous pass.
+			case containsPod(selected, pod):
+				if _, ok := statefulSetOwner(pod); ok {
+					evictionOrder = append(evictionOrder, pod.Name)
+
+					terminating := pod.DeepCopy()
+					terminating.DeletionTimestamp = &metav1.Time{}
+					next = append(next, terminating)
+				}
+			default:
+				next = append(next, pod)
+			}
+		}
+
+		if pass == 0 {
+			assert.ElementsMatch(t, []string{"db-2", "standalone"}, podNames(selected),
+				"first pass should evict the highest ordinal and non-StatefulSet pods")
+		}
+
+		remaining = next
+	}
+
+	require.Empty(t, remaining)
+	assert.Equal(t, []string{"db-2", "db-1", "db-0"}, evictionOrder)
+}
+
+func TestSelectPodsForEviction_WaitsForTerminatingPod(t *testing.T) {
+	terminating := makeStatefulSetPod("db", 2)
+	terminating.DeletionTimestamp = &metav1.Time{}
+
+	pods := []*v1.Pod{makeStatefulSetPod("db", 0), makeStatefulSetPod("db", 1), terminating}
+
+	assert.Empty(t, selectPodsForEviction(pods, true))
+}
+
+func TestSelectPodsForEviction_Parallel(t *testing.T) {
+	pods := []*v1.Pod{makeStatefulSetPod("db", 0), makeStatefulSetPod("db", 1), makeStatefulSetPod("db", 2)}
+
+	assert.Equal(t, []string{"db-0", "db-1", "db-2"}, podNames(selectPodsForEviction(pods, false)))
+}
+
+func containsPod(pods []*v1.Pod, target *v1.Pod) bool {
+	for _, pod := range pods {
+		if pod == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestImmediateEvictionMessage(t *testing.T) {
+	standalone := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: "default"}}
+	podNames, statefulSetPodNames := splitStatefulSetPodNames([]*v1.Pod{
+		makeStatefulSetPod("db", 1),
+		standalone,
+		makeStatefulSetPod("db", 0),
+	})
+
+	assert.Equal(t, []string{"default/standalone"}, podNames)
+	assert.Equal(t, []string{"default/db-0", "default/db-1"}, statefulSetPodNames)
+
+	assert.Equal(t,
+		"Waiting for following pods to be evicted: [default/standalone]; "+
+			"Waiting for following StatefulSet pods (evicted one at a time, highest ordinal first): "+
+			"[default/db-0 default/db-1]",
+		immediateEvictionMessage(podNames, statefulSetPodNames, true))
+	assert.Equal(t,
+		"Waiting for following StatefulSet pods (evicted in parallel): [default/db-0 default/db-1]",
+		immediateEvictionMessage(nil, statefulSetPodNames, false))
+}
+
+func TestCheckIfAllPodsAreEvictedInImmediateMode_ReportsStatefulSetPods(t *testing.T) {
+	i, clientset := newProgressTestInformers(t, 0, 0)
+	i.SetOrderedStatefulSetEviction(true)
+
+	pod := makeStatefulSetPod("db", 0)
+	pod.Spec.NodeName = "node-1"
+	pod.Status.Phase = v1.PodRunning
+	require.NoError(t, i.podInformer.GetIndexer().Add(pod))
+
+	assert.False(t, i.CheckIfAllPodsAreEvictedInImmediateMode(context.Background(),
+		[]string{"default"}, "node-1", time.Minute, nil))
+
+	var messages []string
+
+	for _, action := range clientset.Actions() {
+		if create, ok := action.(clienttesting.CreateAction); ok && action.GetResource().Resource == "events" {
+			messages = append(messages, create.GetObject().(*v1.Event).Message)
+		}
+	}
+
+	require.Len(t, messages, 1)
+	assert.Contains(t, messages[0], "default/db-0", "StatefulSet pods must be named in the node event")
+}
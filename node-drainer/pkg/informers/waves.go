@@ -0,0 +1,63 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// SetEvictionWaves evicts the pods of each namespace on a node in waves of waveSize pods, pausing
+// waveDelay between waves so the scheduler can place the evicted pods before the next wave. A wave
+// that fails to evict any of its pods stops the pass; the remaining pods are evicted on the next
+// drain pass. A wave size of zero evicts all pods at once.
+func (i *Informers) SetEvictionWaves(waveSize int, waveDelay time.Duration) {
+	i.evictionWaveSize = waveSize
+	i.evictionWaveDelay = waveDelay
+}
+
+// splitIntoWaves splits pods into consecutive waves of at most waveSize pods.
+func splitIntoWaves(pods []*v1.Pod, waveSize int) [][]*v1.Pod {
+	if waveSize <= 0 || len(pods) <= waveSize {
+		return [][]*v1.Pod{pods}
+	}
+
+	waves := make([][]*v1.Pod, 0, (len(pods)+waveSize-1)/waveSize)
+	for start := 0; start < len(pods); start += waveSize {
+		waves = append(waves, pods[start:min(start+waveSize, len(pods))])
+	}
+
+	return waves
+}
+
+// waitForNextWave pauses for delay, returning early if ctx is cancelled.
+func waitForNextWave(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for next eviction wave: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package informers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newWaveTestInformers returns informers holding podCount pods of tenant-a on node-1. Each eviction
+// calls evict with the number of evictions requested so far; a non-nil error fails that eviction.
+func newWaveTestInformers(t *testing.T, podCount int, evict func(n int) error) *Informers {
+	t.Helper()
+
+	var (
+		mu        sync.Mutex
+		requested int
+	)
+
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "pods",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "eviction" {
+				return false, nil, nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			requested++
+
+			return true, nil, evict(requested)
+		})
+
+	i, err := NewInformers(clientset, 0, nil, false)
+	require.NoError(t, err)
+
+	for n := range podCount {
+		require.NoError(t, i.podInformer.GetIndexer().Add(&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod-%d", n), Namespace: "tenant-a"},
+			Spec:       v1.PodSpec{NodeName: "node-1"},
+		}))
+	}
+
+	return i
+}
+
+func TestEvictionWaves(t *testing.T) {
+	const waveDelay = 100 * time.Millisecond
+
+	var evictedAt []time.Time
+
+	i := newWaveTestInformers(t, 10, func(int) error {
+		evictedAt = append(evictedAt, time.Now())
+		return nil
+	})
+	i.SetEvictionWaves(3, waveDelay)
+
+	start := time.Now()
+	require.NoError(t, i.EvictAllPodsInImmediateMode(context.Background(), "tenant-a", "node-1", time.Minute, nil))
+	require.Len(t, evictedAt, 10)
+
+	// Evictions within a wave are concurrent, and each wave starts one delay after the previous one.
+	waves := make([]int, 4)
+	for _, at := range evictedAt {
+		wave := int(at.Sub(start) / waveDelay)
+		require.Less(t, wave, len(waves), "eviction at %s is later than the last wave", at.Sub(start))
+		waves[wave]++
+	}
+
+	assert.Equal(t, []int{3, 3, 3, 1}, waves)
+}
+
+func TestEvictionWavesStopAfterFailedWave(t *testing.T) {
+	var requested int
+
+	i := newWaveTestInformers(t, 10, func(n int) error {
+		requested = n
+		if n == 1 {
+			return apierrors.NewTooManyRequests("disruption budget exhausted", 0)
+		}
+
+		return nil
+	})
+	i.SetEvictionWaves(3, time.Millisecond)
+
+	err := i.EvictAllPodsInImmediateMode(context.Background(), "tenant-a", "node-1", time.Minute, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "disruption budget exhausted")
+	assert.Equal(t, 3, requested, "the waves after the failed one are not evicted")
+}
+
+func TestEvictionWavesCancelledBetweenWaves(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var requested int
+
+	i := newWaveTestInformers(t, 10, func(n int) error {
+		requested = n
+		if n == 3 {
+			cancel()
+		}
+
+		return nil
+	})
+	i.SetEvictionWaves(3, time.Hour)
+
+	err := i.EvictAllPodsInImmediateMode(ctx, "tenant-a", "node-1", time.Minute, nil)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 3, requested)
+}
+
+func TestSplitIntoWaves(t *testing.T) {
+	pods := make([]*v1.Pod, 7)
+
+	for _, tc := range []struct {
+		waveSize int
+		want     []int
+	}{
+		{waveSize: 0, want: []int{7}},
+		{waveSize: 3, want: []int{3, 3, 1}},
+		{waveSize: 7, want: []int{7}},
+		{waveSize: 10, want: []int{7}},
+	} {
+		var sizes []int
+		for _, wave := range splitIntoWaves(pods, tc.waveSize) {
+			sizes = append(sizes, len(wave))
+		}
+
+		assert.Equal(t, tc.want, sizes, "wave size %d", tc.waveSize)
+	}
+}
@@ -103,6 +103,21 @@ func InitializeAll(ctx context.Context, params InitializationParams) (*Component
 		return nil, fmt.Errorf("error while initializing informers: %w", err)
 	}
 
+	informersInstance.SetOrderedStatefulSetEviction(
+		configs.tomlCfg.StatefulSetDrainStrategy == config.StatefulSetDrainOrdered)
+	informersInstance.SetGPUWorkloadsOnly(configs.tomlCfg.DrainGPUWorkloadsOnly)
+	informersInstance.SetDrainProgressBatching(configs.tomlCfg.DrainProgress.UpdateInterval(),
+		configs.tomlCfg.DrainProgress.UpdateEvictionBatch)
+	informersInstance.SetNamespaceEvictionRateLimit(configs.tomlCfg.NamespaceEvictionRateLimit.EvictionsPerSecond,
+		configs.tomlCfg.NamespaceEvictionRateLimit.Burst)
+	informersInstance.SetEvictionWaves(configs.tomlCfg.EvictionWaves.WaveSize,
+		configs.tomlCfg.EvictionWaves.WaveDelay())
+
+	if configs.tomlCfg.PreEvictionSignal.Enabled {
+		informersInstance.SetPreEvictionSignal(configs.tomlCfg.PreEvictionSignal.AnnotationKey,
+			configs.tomlCfg.PreEvictionSignal.LeadTime())
+	}
+
 	stateManager := initializeStateManager(clientSet)
 
 	// IMPORTANT: Preserves ClientName="node-drainer" for resume token lookups
@@ -119,6 +119,15 @@ var (
 		},
 	)
 
+	// EventsStuck tracks events that have been draining longer than the configured threshold
+	EventsStuck = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "nvsentinel_events_stuck",
+			Help: "Number of events that have been in the given phase longer than the stuck event threshold.",
+		},
+		[]string{"phase"},
+	)
+
 	// CustomDrainCRDNotFound tracks failures when custom drain CRD is not found
 	CustomDrainCRDNotFound = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -127,4 +136,31 @@ var (
 		},
 		[]string{"node"},
 	)
+
+	// PreEvictionSignalsSent tracks checkpoint signals sent to pods ahead of eviction
+	PreEvictionSignalsSent = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_drainer_pre_eviction_signals_total",
+			Help: "Total number of pods annotated with a checkpoint request ahead of eviction.",
+		},
+		[]string{"node"},
+	)
+
+	// DrainProgressUpdatesSkipped tracks drain progress updates held back by batching
+	DrainProgressUpdatesSkipped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_drainer_drain_progress_updates_skipped_total",
+			Help: "Total number of drain progress updates not written to the node Event because of batching.",
+		},
+		[]string{"node"},
+	)
+
+	// NamespaceEvictionsThrottled tracks evictions delayed by the per-namespace rate limit
+	NamespaceEvictionsThrottled = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "node_drainer_namespace_evictions_throttled_total",
+			Help: "Total number of pod evictions delayed by the per-namespace eviction rate limit.",
+		},
+		[]string{"namespace"},
+	)
 )
@@ -64,6 +64,7 @@ type Reconciler struct {
 	nodeEventsMap       map[string]eventStatusMap
 	cancelledNodes      map[string]struct{}
 	nodeEventsMapMu     sync.Mutex
+	stuckEvents         *stuckEventTracker
 }
 
 func NewReconciler(
@@ -104,6 +105,8 @@ func NewReconciler(
 		customDrainClient:   customDrainClient,
 		nodeEventsMap:       make(map[string]eventStatusMap),
 		cancelledNodes:      make(map[string]struct{}),
+		stuckEvents: newStuckEventTracker(
+			time.Duration(cfg.TomlConfig.StuckEventThresholdMinutes) * time.Minute),
 	}
 
 	queueManager.SetDataStoreEventProcessor(reconciler)
@@ -380,11 +383,15 @@ func (r *Reconciler) ProcessEventGeneric(ctx context.Context,
 
 	metrics.TotalEventsReceived.Inc()
 
+	r.stuckEvents.observe(eventID, &healthEventWithStatus)
+
 	nodeQuarantinedStatus := healthEventWithStatus.HealthEventStatus.NodeQuarantined
 
 	if r.isEventCancelled(eventID, nodeName, (*model.Status)(&nodeQuarantinedStatus)) {
 		slog.InfoContext(ctx, "Event was cancelled, performing cleanup", "node", nodeName, "eventID", eventID)
 
+		r.stuckEvents.forget(eventID)
+
 		err := r.handleCancelledEvent(ctx, nodeName, &healthEventWithStatus, event, database, eventID)
 		if err != nil {
 			span := tracing.SpanFromContext(ctx)
@@ -431,6 +438,8 @@ func (r *Reconciler) ProcessEventGeneric(ctx context.Context,
 				attribute.String("node_drainer.error.message", err.Error()),
 			)
 		}
+	} else {
+		r.stuckEvents.forget(eventID)
 	}
 
 	return err
@@ -680,7 +689,8 @@ func (r *Reconciler) executeCheckCompletion(ctx context.Context, action *evaluat
 		message := fmt.Sprintf("Waiting for following pods to finish: %v", remainingPods)
 		reason := "AwaitingPodCompletion"
 
-		if err := r.informers.UpdateNodeEvent(ctx, nodeName, reason, message); err != nil {
+		if err := r.informers.UpdateNodeDrainProgress(ctx, nodeName, reason, message,
+			len(remainingPods)); err != nil {
 			// Don't fail the whole operation just because event update failed
 			slog.ErrorContext(ctx, "Failed to update node event",
 				"node", nodeName,
@@ -700,6 +710,7 @@ func (r *Reconciler) executeCheckCompletion(ctx context.Context, action *evaluat
 	}
 
 	slog.InfoContext(ctx, "All pods completed on node", "node", nodeName)
+	r.informers.ClearDrainProgress(nodeName)
 
 	return fmt.Errorf("pod completion verified, requeuing for status update")
 }
@@ -1047,6 +1058,7 @@ func (r *Reconciler) handleCancelledEvent(ctx context.Context, nodeName string,
 	defer span.End()
 
 	r.clearEventStatus(eventID, nodeName)
+	r.informers.ClearDrainProgress(nodeName)
 
 	if healthEvent.HealthEventStatus.UserPodsEvictionStatus == nil {
 		slog.ErrorContext(ctx, "HealthEventStatus is missing UserPodsEvictionStatus",
@@ -837,6 +837,41 @@ func TestReconciler_DryRunMode(t *testing.T) {
 	}, 30*time.Second, 1*time.Second)
 }
 
+// TestReconciler_GPUWorkloadsOnlyDrain validates that in GPU-only drain mode only pods requesting
+// GPU resources are evicted, while CPU-only pods on the same node keep running.
+func TestReconciler_GPUWorkloadsOnlyDrain(t *testing.T) {
+	setup := setupDirectTest(t, []config.UserNamespace{
+		{Name: "immediate-*", Mode: config.ModeImmediateEvict},
+	}, false)
+	setup.informersInstance.SetGPUWorkloadsOnly(true)
+
+	nodeName := "gpu-only-node"
+	createNode(setup.ctx, t, setup.client, nodeName)
+	createNamespace(setup.ctx, t, setup.client, "immediate-test")
+	createPod(setup.ctx, t, setup.client, "immediate-test", "gpu-pod", nodeName, v1.PodRunning, nil,
+		v1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")})
+	createPod(setup.ctx, t, setup.client, "immediate-test", "cpu-pod", nodeName, v1.PodRunning, nil,
+		v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")})
+
+	err := processHealthEvent(setup.ctx, t, setup.reconciler, setup.mockCollection, setup.healthEventStore,
+		healthEventOptions{
+			nodeName:        nodeName,
+			nodeQuarantined: model.Quarantined,
+		})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "immediate eviction completed, requeuing for status verification")
+
+	require.Eventually(t, func() bool {
+		pod, err := setup.client.CoreV1().Pods("immediate-test").Get(setup.ctx, "gpu-pod", metav1.GetOptions{})
+		return err != nil || pod.DeletionTimestamp != nil
+	}, 30*time.Second, 1*time.Second, "GPU pod should be evicted")
+
+	assert.Never(t, func() bool {
+		pod, err := setup.client.CoreV1().Pods("immediate-test").Get(setup.ctx, "cpu-pod", metav1.GetOptions{})
+		return err != nil || pod.DeletionTimestamp != nil
+	}, 3*time.Second, 500*time.Millisecond, "CPU-only pod should not be evicted")
+}
+
 // TestReconciler_RequeueMechanism validates that the queue requeues events for multi-step workflows.
 // Tests immediate eviction triggers requeue, pods get evicted, and node transitions to drain-succeeded.
 func TestReconciler_RequeueMechanism(t *testing.T) {
@@ -0,0 +1,119 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+// PhaseDraining is the only phase node-drainer reports in the nvsentinel_events_stuck metric. Events
+// reach node-drainer once they are quarantined and leave its reconcile loop once the drain finishes,
+// so the quarantining and remediating phases are not observable here.
+const PhaseDraining = "Draining"
+
+// stuckEventTracker keeps the nvsentinel_events_stuck gauge in sync with the events seen during reconcile.
+// An event counts as stuck once it has been draining for longer than threshold, measured from the
+// timestamp of its quarantine.
+type stuckEventTracker struct {
+	threshold time.Duration
+	now       func() time.Time
+
+	mu    sync.Mutex
+	stuck map[string]string // eventID -> phase
+}
+
+func newStuckEventTracker(threshold time.Duration) *stuckEventTracker {
+	return &stuckEventTracker{
+		threshold: threshold,
+		now:       time.Now,
+		stuck:     make(map[string]string),
+	}
+}
+
+// observe re-evaluates whether the event is stuck based on its current status.
+func (t *stuckEventTracker) observe(eventID string, event *model.HealthEventWithStatus) {
+	phase, since, ok := eventPhase(event)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	previous, wasStuck := t.stuck[eventID]
+
+	if ok && !since.IsZero() && t.now().Sub(since) > t.threshold {
+		if wasStuck && previous == phase {
+			return
+		}
+
+		if wasStuck {
+			metrics.EventsStuck.WithLabelValues(previous).Dec()
+		}
+
+		t.stuck[eventID] = phase
+		metrics.EventsStuck.WithLabelValues(phase).Inc()
+
+		return
+	}
+
+	if wasStuck {
+		delete(t.stuck, eventID)
+		metrics.EventsStuck.WithLabelValues(previous).Dec()
+	}
+}
+
+// forget stops tracking an event that is no longer being reconciled.
+func (t *stuckEventTracker) forget(eventID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if phase, ok := t.stuck[eventID]; ok {
+		delete(t.stuck, eventID)
+		metrics.EventsStuck.WithLabelValues(phase).Dec()
+	}
+}
+
+// eventPhase returns the phase the event is in and when it entered that phase. ok is false unless the
+// event is quarantined and still waiting for, or in the middle of, its drain.
+func eventPhase(event *model.HealthEventWithStatus) (phase string, since time.Time, ok bool) {
+	status := event.HealthEventStatus
+	if status == nil {
+		return "", time.Time{}, false
+	}
+
+	switch model.Status(status.NodeQuarantined) {
+	case model.Quarantined, model.AlreadyQuarantined:
+	default:
+		return "", time.Time{}, false
+	}
+
+	evictionStatus := model.StatusNotStarted
+	if status.UserPodsEvictionStatus != nil && status.UserPodsEvictionStatus.Status != "" {
+		evictionStatus = model.Status(status.UserPodsEvictionStatus.Status)
+	}
+
+	if evictionStatus != model.StatusNotStarted && evictionStatus != model.StatusInProgress {
+		return "", time.Time{}, false
+	}
+
+	since = event.CreatedAt
+	if ts := status.GetQuarantineFinishTimestamp(); ts != nil {
+		since = ts.AsTime()
+	}
+
+	return PhaseDraining, since, true
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reconciler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nvidia/nvsentinel/data-models/pkg/model"
+	"github.com/nvidia/nvsentinel/data-models/pkg/protos"
+	"github.com/nvidia/nvsentinel/node-drainer/pkg/metrics"
+)
+
+func TestStuckEventTracker_AgedDrainingEvent(t *testing.T) {
+	quarantinedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := quarantinedAt
+
+	tracker := newStuckEventTracker(30 * time.Minute)
+	tracker.now = func() time.Time { return clock }
+
+	event := &model.HealthEventWithStatus{
+		CreatedAt:   quarantinedAt.Add(-time.Minute),
+		HealthEvent: &protos.HealthEvent{NodeName: "node-1"},
+		HealthEventStatus: &protos.HealthEventStatus{
+			NodeQuarantined:           string(model.Quarantined),
+			QuarantineFinishTimestamp: timestamppb.New(quarantinedAt),
+			UserPodsEvictionStatus:    &protos.OperationStatus{Status: string(model.StatusInProgress)},
+		},
+	}
+
+	baseline := testutil.ToFloat64(metrics.EventsStuck.WithLabelValues(PhaseDraining))
+
+	clock = quarantinedAt.Add(10 * time.Minute)
+	tracker.observe("event-1", event)
+	assert.Equal(t, baseline, testutil.ToFloat64(metrics.EventsStuck.WithLabelValues(PhaseDraining)),
+		"event draining for less than the threshold should not be stuck")
+
+	clock = quarantinedAt.Add(31 * time.Minute)
+	tracker.observe("event-1", event)
+	assert.Equal(t, baseline+1, testutil.ToFloat64(metrics.EventsStuck.WithLabelValues(PhaseDraining)),
+		"aged draining event should be reported as stuck")
+
+	tracker.observe("event-1", event)
+	assert.Equal(t, baseline+1, testutil.ToFloat64(metrics.EventsStuck.WithLabelValues(PhaseDraining)),
+		"re-observing a stuck event should not count it twice")
+
+	event.HealthEventStatus.UserPodsEvictionStatus.Status = string(model.StatusFailed)
+	tracker.observe("event-1", event)
+	assert.Equal(t, baseline, testutil.ToFloat64(metrics.EventsStuck.WithLabelValues(PhaseDraining)),
+		"event reaching a terminal state should no longer be stuck")
+}
+
+func TestStuckEventTracker_Forget(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tracker := newStuckEventTracker(time.Minute)
+	tracker.now = func() time.Time { return createdAt.Add(time.Hour) }
+
+	event := &model.HealthEventWithStatus{
+		CreatedAt:         createdAt,
+		HealthEvent:       &protos.HealthEvent{NodeName: "node-1"},
+		HealthEventStatus: &protos.HealthEventStatus{NodeQuarantined: string(model.Quarantined)},
+	}
+
+	baseline := testutil.ToFloat64(metrics.EventsStuck.WithLabelValues(PhaseDraining))
+
+	tracker.observe("event-2", event)
+	assert.Equal(t, baseline+1, testutil.ToFloat64(metrics.EventsStuck.WithLabelValues(PhaseDraining)))
+
+	tracker.forget("event-2")
+	assert.Equal(t, baseline, testutil.ToFloat64(metrics.EventsStuck.WithLabelValues(PhaseDraining)))
+}
+
+func TestEventPhase(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	quarantinedAt := createdAt.Add(time.Minute)
+	drainedAt := createdAt.Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		status        *protos.HealthEventStatus
+		expectedPhase string
+		expectedSince time.Time
+		expectedOK    bool
+	}{
+		{
+			name:       "not yet quarantined",
+			status:     &protos.HealthEventStatus{},
+			expectedOK: false,
+		},
+		{
+			name: "quarantined and draining",
+			status: &protos.HealthEventStatus{
+				NodeQuarantined:           string(model.Quarantined),
+				QuarantineFinishTimestamp: timestamppb.New(quarantinedAt),
+				UserPodsEvictionStatus:    &protos.OperationStatus{Status: string(model.StatusInProgress)},
+			},
+			expectedPhase: PhaseDraining,
+			expectedSince: quarantinedAt,
+			expectedOK:    true,
+		},
+		{
+			name: "drained",
+			status: &protos.HealthEventStatus{
+				NodeQuarantined:        string(model.Quarantined),
+				UserPodsEvictionStatus: &protos.OperationStatus{Status: string(model.StatusSucceeded)},
+				DrainFinishTimestamp:   timestamppb.New(drainedAt),
+			},
+			expectedOK: false,
+		},
+		{
+			name:       "unquarantined",
+			status:     &protos.HealthEventStatus{NodeQuarantined: string(model.UnQuarantined)},
+			expectedOK: false,
+		},
+		{
+			name: "drain failed",
+			status: &protos.HealthEventStatus{
+				NodeQuarantined:        string(model.Quarantined),
+				UserPodsEvictionStatus: &protos.OperationStatus{Status: string(model.StatusFailed)},
+			},
+			expectedOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			phase, since, ok := eventPhase(&model.HealthEventWithStatus{
+				CreatedAt:         createdAt,
+				HealthEventStatus: tt.status,
+			})
+
+			assert.Equal(t, tt.expectedOK, ok)
+
+			if tt.expectedOK {
+				assert.Equal(t, tt.expectedPhase, phase)
+				assert.True(t, tt.expectedSince.Equal(since), "expected since %v, got %v", tt.expectedSince, since)
+			}
+		})
+	}
+}
@@ -221,6 +221,7 @@ func startGRPCServer(
 	ctx context.Context,
 	socket string,
 	pipeline *pipeline.Pipeline,
+	minSeverity server.Severity,
 ) (net.Listener, error) {
 	slog.InfoContext(ctx, "Starting gRPC server on Unix socket", "socket", socket)
 
@@ -247,9 +248,12 @@ func startGRPCServer(
 
 	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterPlatformConnectorServer(grpcServer, &server.PlatformConnectorServer{
-		Pipeline: pipeline,
+		Pipeline:    pipeline,
+		MinSeverity: minSeverity,
 	})
 
+	slog.InfoContext(ctx, "Minimum health event severity configured", "minSeverity", minSeverity)
+
 	go func() {
 		slog.InfoContext(ctx, "Starting gRPC server listener", "socket", socket)
 
@@ -486,7 +490,14 @@ func run() error {
 		return fmt.Errorf("failed to initialize pipeline: %w", err)
 	}
 
-	lis, err := startGRPCServer(ctx, cfg.socket, pipeline)
+	minSeverityName, _ := config["MinSeverity"].(string)
+
+	minSeverity, err := server.ParseSeverity(minSeverityName)
+	if err != nil {
+		return fmt.Errorf("failed to parse MinSeverity: %w", err)
+	}
+
+	lis, err := startGRPCServer(ctx, cfg.socket, pipeline, minSeverity)
 	if err != nil {
 		return err
 	}
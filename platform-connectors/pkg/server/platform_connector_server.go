@@ -45,11 +45,17 @@ var (
 		Name: "platform_connector_health_events_received_total",
 		Help: "The total number of health events that the platform connector has received",
 	})
+	conflictingHealthEvents = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "platform_connector_conflicting_health_events_total",
+		Help: "The total number of health events received with both isFatal and isHealthy set",
+	})
 )
 
 type PlatformConnectorServer struct {
 	pb.UnimplementedPlatformConnectorServer
 	Pipeline *pipeline.Pipeline
+	// MinSeverity is the lowest severity of unhealthy events that are forwarded to the connectors.
+	MinSeverity Severity
 }
 
 func (p *PlatformConnectorServer) HealthEventOccurredV1(ctx context.Context,
@@ -64,6 +70,7 @@ func (p *PlatformConnectorServer) HealthEventOccurredV1(ctx context.Context,
 
 	slog.InfoContext(ctx, "Health events received", "events", he)
 	healthEventsReceived.Add(float64(eventCount))
+	countBySource(ctx, he.Events)
 
 	for _, event := range he.Events {
 		// Custom monitors that don't set processingStrategy will default to EXECUTE_REMEDIATION.
@@ -71,6 +78,19 @@ func (p *PlatformConnectorServer) HealthEventOccurredV1(ctx context.Context,
 			event.ProcessingStrategy = pb.ProcessingStrategy_EXECUTE_REMEDIATION
 		}
 
+		// A healthy event clears a fault, so it cannot also report a fatal one. Some monitors
+		// copy isFatal from a static policy onto their recovery events, so rather than reject
+		// them, isHealthy takes precedence and isFatal is cleared.
+		if event.IsFatal && event.IsHealthy {
+			slog.WarnContext(ctx, "Health event is both fatal and healthy, treating it as healthy",
+				"node", event.NodeName,
+				"agent", event.Agent,
+				"checkName", event.CheckName)
+			conflictingHealthEvents.Inc()
+
+			event.IsFatal = false
+		}
+
 		if event.RecommendedAction == pb.RecommendedAction_CUSTOM && event.CustomRecommendedAction == "" {
 			return nil, status.Errorf(codes.InvalidArgument,
 				"recommendedAction is CUSTOM but customRecommendedAction is empty (node=%s, agent=%s)",
@@ -78,6 +98,11 @@ func (p *PlatformConnectorServer) HealthEventOccurredV1(ctx context.Context,
 		}
 	}
 
+	he.Events = p.filterBySeverity(ctx, he.Events)
+	if len(he.Events) == 0 {
+		return nil, nil
+	}
+
 	if p.Pipeline != nil {
 		for i := range he.Events {
 			p.Pipeline.Process(ctx, he.Events[i])
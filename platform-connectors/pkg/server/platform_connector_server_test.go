@@ -66,3 +66,40 @@ func TestHealthEventOccurredV1_ProcessingStrategyNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestHealthEventOccurredV1_FatalHealthyNormalization(t *testing.T) {
+	tests := []struct {
+		name            string
+		isFatal         bool
+		isHealthy       bool
+		expectedIsFatal bool
+	}{
+		{name: "fatal and unhealthy is unchanged", isFatal: true, isHealthy: false, expectedIsFatal: true},
+		{name: "non-fatal and unhealthy is unchanged", isFatal: false, isHealthy: false, expectedIsFatal: false},
+		{name: "non-fatal and healthy is unchanged", isFatal: false, isHealthy: true, expectedIsFatal: false},
+		{name: "fatal and healthy is normalized to healthy", isFatal: true, isHealthy: true, expectedIsFatal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := &PlatformConnectorServer{}
+
+			healthEvents := &pb.HealthEvents{
+				Events: []*pb.HealthEvent{
+					{
+						NodeName:  "test-node",
+						CheckName: "test-check",
+						IsFatal:   tt.isFatal,
+						IsHealthy: tt.isHealthy,
+					},
+				},
+			}
+
+			_, err := server.HealthEventOccurredV1(context.Background(), healthEvents)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedIsFatal, healthEvents.Events[0].IsFatal)
+			assert.Equal(t, tt.isHealthy, healthEvents.Events[0].IsHealthy)
+		})
+	}
+}
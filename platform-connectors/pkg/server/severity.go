@@ -0,0 +1,111 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+)
+
+// Severity orders unhealthy health events by how much attention they need.
+type Severity int
+
+const (
+	// SeverityInfo is a non-fatal event that recommends no action.
+	SeverityInfo Severity = iota
+	// SeverityWarning is a non-fatal event that recommends an action.
+	SeverityWarning
+	// SeverityFatal is a fatal event.
+	SeverityFatal
+)
+
+var belowMinSeverityHealthEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "platform_connector_health_events_below_min_severity_total",
+	Help: "The total number of health events dropped because their severity is below the configured minimum",
+}, []string{"source", "severity"})
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityFatal:
+		return "fatal"
+	default:
+		return fmt.Sprintf("severity(%d)", int(s))
+	}
+}
+
+// ParseSeverity parses a minimum severity name. An empty name means no minimum.
+func ParseSeverity(name string) (Severity, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "info":
+		return SeverityInfo, nil
+	case "warning":
+		return SeverityWarning, nil
+	case "fatal":
+		return SeverityFatal, nil
+	default:
+		return SeverityInfo, fmt.Errorf("invalid severity %q: must be one of info, warning, fatal", name)
+	}
+}
+
+// EventSeverity returns the severity of an unhealthy event.
+func EventSeverity(event *pb.HealthEvent) Severity {
+	switch {
+	case event.IsFatal:
+		return SeverityFatal
+	case event.RecommendedAction != pb.RecommendedAction_NONE:
+		return SeverityWarning
+	default:
+		return SeverityInfo
+	}
+}
+
+// filterBySeverity drops unhealthy events below the minimum severity. Healthy events are always
+// kept since they clear conditions raised by earlier events.
+func (p *PlatformConnectorServer) filterBySeverity(ctx context.Context, events []*pb.HealthEvent) []*pb.HealthEvent {
+	if p.MinSeverity == SeverityInfo {
+		return events
+	}
+
+	kept := events[:0]
+
+	for _, event := range events {
+		severity := EventSeverity(event)
+		if event.IsHealthy || severity >= p.MinSeverity {
+			kept = append(kept, event)
+			continue
+		}
+
+		slog.DebugContext(ctx, "Dropping health event below minimum severity",
+			"node", event.NodeName,
+			"agent", event.Agent,
+			"checkName", event.CheckName,
+			"severity", severity,
+			"minSeverity", p.MinSeverity)
+		belowMinSeverityHealthEvents.WithLabelValues(NormalizeSource(event.Agent), severity.String()).Inc()
+	}
+
+	return kept
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+)
+
+func TestParseSeverity(t *testing.T) {
+	for name, expected := range map[string]Severity{
+		"":        SeverityInfo,
+		"info":    SeverityInfo,
+		"Warning": SeverityWarning,
+		" fatal ": SeverityFatal,
+	} {
+		severity, err := ParseSeverity(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, expected, severity, name)
+	}
+
+	_, err := ParseSeverity("critical")
+	assert.Error(t, err)
+}
+
+func TestHealthEventOccurredV1_MinSeverity(t *testing.T) {
+	const agent = "Syslog-Health-Monitor"
+
+	infoEvent := &pb.HealthEvent{Agent: agent, CheckName: "info", RecommendedAction: pb.RecommendedAction_NONE}
+	warningEvent := &pb.HealthEvent{Agent: agent, CheckName: "warning", RecommendedAction: pb.RecommendedAction_RESTART_VM}
+	fatalEvent := &pb.HealthEvent{Agent: agent, CheckName: "fatal", IsFatal: true}
+	healthyEvent := &pb.HealthEvent{Agent: agent, CheckName: "healthy", IsHealthy: true}
+	customEvent := &pb.HealthEvent{Agent: "custom-monitor-1234", CheckName: "info"}
+
+	droppedInfo := belowMinSeverityHealthEvents.WithLabelValues(SourceSyslogHealthMonitor, "info")
+	droppedWarning := belowMinSeverityHealthEvents.WithLabelValues(SourceSyslogHealthMonitor, "warning")
+	droppedOther := belowMinSeverityHealthEvents.WithLabelValues(SourceOther, "info")
+	beforeInfo := testutil.ToFloat64(droppedInfo)
+	beforeWarning := testutil.ToFloat64(droppedWarning)
+	beforeOther := testutil.ToFloat64(droppedOther)
+
+	server := &PlatformConnectorServer{MinSeverity: SeverityWarning}
+	healthEvents := &pb.HealthEvents{
+		Events: []*pb.HealthEvent{infoEvent, warningEvent, fatalEvent, healthyEvent, customEvent},
+	}
+
+	_, err := server.HealthEventOccurredV1(context.Background(), healthEvents)
+	require.NoError(t, err)
+
+	assert.Equal(t, []*pb.HealthEvent{warningEvent, fatalEvent, healthyEvent}, healthEvents.Events,
+		"events at or above the minimum and healthy events are kept")
+	assert.Equal(t, beforeInfo+1, testutil.ToFloat64(droppedInfo), "drops are counted by normalized source")
+	assert.Equal(t, beforeWarning, testutil.ToFloat64(droppedWarning))
+	assert.Equal(t, beforeOther+1, testutil.ToFloat64(droppedOther), "unknown agents are counted as other")
+
+	server.MinSeverity = SeverityFatal
+	healthEvents = &pb.HealthEvents{Events: []*pb.HealthEvent{warningEvent}}
+
+	_, err = server.HealthEventOccurredV1(context.Background(), healthEvents)
+	require.NoError(t, err)
+
+	assert.Empty(t, healthEvents.Events)
+	assert.Equal(t, beforeWarning+1, testutil.ToFloat64(droppedWarning))
+}
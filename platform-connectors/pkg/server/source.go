@@ -0,0 +1,91 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+)
+
+// Sources of health events, taken from the agent name each NVSentinel monitor reports.
+const (
+	SourceGPUHealthMonitor        = "gpu-health-monitor"
+	SourceSyslogHealthMonitor     = "syslog-health-monitor"
+	SourceCSPHealthMonitor        = "csp-health-monitor"
+	SourceNICHealthMonitor        = "nic-health-monitor"
+	SourceKubernetesObjectMonitor = "kubernetes-object-monitor"
+	SourceSlurmDrainMonitor       = "slurm-drain-monitor"
+	SourceHealthEventsAnalyzer    = "health-events-analyzer"
+	// SourcePreflight buckets the preflight check agents (preflight-dcgm-diag, preflight-nccl-loopback,
+	// preflight-nccl-allreduce, ...), which all report under a "preflight-" prefix.
+	SourcePreflight = "preflight"
+	// SourceOther buckets events from agents outside the taxonomy, such as custom monitors, so the
+	// source label stays bounded.
+	SourceOther = "other"
+)
+
+var knownSources = map[string]struct{}{
+	SourceGPUHealthMonitor:        {},
+	SourceSyslogHealthMonitor:     {},
+	SourceCSPHealthMonitor:        {},
+	SourceNICHealthMonitor:        {},
+	SourceKubernetesObjectMonitor: {},
+	SourceSlurmDrainMonitor:       {},
+	SourceHealthEventsAnalyzer:    {},
+}
+
+const preflightAgentPrefix = SourcePreflight + "-"
+
+var healthEventsReceivedBySource = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "platform_connector_health_events_received_by_source_total",
+	Help: "The total number of health events that the platform connector has received, by source",
+}, []string{"source"})
+
+// NormalizeSource maps an agent name onto the source taxonomy. Preflight check agents map to
+// SourcePreflight and agents outside the taxonomy map to SourceOther.
+func NormalizeSource(agent string) string {
+	source := strings.ToLower(strings.TrimSpace(agent))
+	if _, ok := knownSources[source]; ok {
+		return source
+	}
+
+	if strings.HasPrefix(source, preflightAgentPrefix) {
+		return SourcePreflight
+	}
+
+	return SourceOther
+}
+
+// countBySource validates each event's agent against the source taxonomy and counts it under
+// its normalized source.
+func countBySource(ctx context.Context, events []*pb.HealthEvent) {
+	for _, event := range events {
+		source := NormalizeSource(event.Agent)
+		if source == SourceOther {
+			slog.DebugContext(ctx, "Health event agent is not a known source, counting it as other",
+				"node", event.NodeName,
+				"agent", event.Agent,
+				"checkName", event.CheckName)
+		}
+
+		healthEventsReceivedBySource.WithLabelValues(source).Inc()
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/nvidia/nvsentinel/data-models/pkg/protos"
+)
+
+func TestNormalizeSource(t *testing.T) {
+	for agent, expected := range map[string]string{
+		"gpu-health-monitor":        SourceGPUHealthMonitor,
+		" Syslog-Health-Monitor ":   SourceSyslogHealthMonitor,
+		"csp-health-monitor":        SourceCSPHealthMonitor,
+		"kubernetes-object-monitor": SourceKubernetesObjectMonitor,
+		"health-events-analyzer":    SourceHealthEventsAnalyzer,
+		"preflight-dcgm-diag":       SourcePreflight,
+		"preflight-nccl-loopback":   SourcePreflight,
+		"Preflight-NCCL-Allreduce":  SourcePreflight,
+		"preflight":                 SourceOther,
+		"memory-pressure-monitor":   SourceOther,
+		"e2e-test":                  SourceOther,
+		"":                          SourceOther,
+	} {
+		assert.Equal(t, expected, NormalizeSource(agent), agent)
+	}
+}
+
+func TestHealthEventOccurredV1_CountsBySource(t *testing.T) {
+	gpu := healthEventsReceivedBySource.WithLabelValues(SourceGPUHealthMonitor)
+	syslog := healthEventsReceivedBySource.WithLabelValues(SourceSyslogHealthMonitor)
+	preflight := healthEventsReceivedBySource.WithLabelValues(SourcePreflight)
+	other := healthEventsReceivedBySource.WithLabelValues(SourceOther)
+	beforePreflight := testutil.ToFloat64(preflight)
+	beforeGPU := testutil.ToFloat64(gpu)
+	beforeSyslog := testutil.ToFloat64(syslog)
+	beforeOther := testutil.ToFloat64(other)
+
+	server := &PlatformConnectorServer{}
+	healthEvents := &pb.HealthEvents{Events: []*pb.HealthEvent{
+		{Agent: "gpu-health-monitor", CheckName: "GpuXidError", IsFatal: true},
+		{Agent: "gpu-health-monitor", CheckName: "GpuMemWatch", IsHealthy: true},
+		{Agent: "syslog-health-monitor", CheckName: "SysLogsXIDError", IsFatal: true},
+		{Agent: "preflight-nccl-loopback", CheckName: "NCCLLoopbackTest", IsFatal: true},
+		{Agent: "custom-monitor", CheckName: "CustomCheck", IsFatal: true},
+		{Agent: "e2e-test", CheckName: "CustomCheck", IsHealthy: true},
+	}}
+
+	_, err := server.HealthEventOccurredV1(context.Background(), healthEvents)
+	require.NoError(t, err)
+
+	assert.Equal(t, beforeGPU+2, testutil.ToFloat64(gpu))
+	assert.Equal(t, beforeSyslog+1, testutil.ToFloat64(syslog))
+	assert.Equal(t, beforePreflight+1, testutil.ToFloat64(preflight))
+	assert.Equal(t, beforeOther+2, testutil.ToFloat64(other), "unknown agents are bucketed as other")
+}
@@ -115,9 +115,13 @@ func setupGangCoordination(ctx context.Context, cfg *config.Config, stop context
 		return fmt.Errorf("failed to create controller manager: %w", err)
 	}
 
+	// Peer lookups are served from the cache, by label selector or by a pod index registered on it.
+	// The API reader is only used by discoverers that read objects the cache does not hold.
 	discoverer, err = gang.NewDiscovererFromConfig(
 		cfg.GangDiscovery,
 		mgr.GetClient(),
+		mgr.GetAPIReader(),
+		mgr.GetCache(),
 		mgr.GetRESTMapper(),
 	)
 	if err != nil {
@@ -125,7 +129,9 @@ func setupGangCoordination(ctx context.Context, cfg *config.Config, stop context
 	}
 
 	coordinatorConfig := gang.CoordinatorConfig{
-		MasterPort: cfg.GangCoordination.MasterPort,
+		MasterPort:          cfg.GangCoordination.MasterPort,
+		MaxConcurrentChecks: cfg.GangCoordination.MaxConcurrentChecks,
+		PeerStaleTimeout:    cfg.GangCoordination.PeerStaleTimeoutDuration,
 	}
 	coordinator := gang.NewCoordinator(mgr.GetClient(), coordinatorConfig)
 
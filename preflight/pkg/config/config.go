@@ -96,6 +96,7 @@ type FileConfig struct {
 // If empty (no Name set), defaults to native K8s 1.35+ WorkloadRef API.
 type GangDiscoveryConfig struct {
 	// Name is the discoverer identifier (used in gangID prefix and logging).
+	// "kubernetes", "jobset" and "ray" select the built-in discoverers when no other fields are set.
 	Name string `yaml:"name,omitempty"`
 
 	// AnnotationKeys are pod annotation keys to check for the PodGroup name (checked in order).
@@ -112,6 +113,18 @@ type GangDiscoveryConfig struct {
 	// Examples: "podGroup.spec.minMember", "podGroup.spec.minReplicas"
 	// Default: "podGroup.spec.minMember"
 	MinCountExpr string `yaml:"minCountExpr,omitempty"`
+
+	// PodPhases are the pod phases accepted as gang peers, for any discoverer.
+	// Default: Running and Pending
+	PodPhases []corev1.PodPhase `yaml:"podPhases,omitempty"`
+
+	// TerminatingPods controls whether pods being deleted, which may still hold their GPUs,
+	// are accepted as gang peers: "Include" or "Exclude" (default).
+	TerminatingPods string `yaml:"terminatingPods,omitempty"`
+
+	// RequireExplicit rejects an empty config instead of defaulting to the Kubernetes native
+	// Workload API. Use name "kubernetes" to select the native discoverer explicitly.
+	RequireExplicit bool `yaml:"requireExplicit,omitempty"`
 }
 
 // GVRConfig specifies a Kubernetes GroupVersionResource.
@@ -138,6 +151,20 @@ type GangCoordinationConfig struct {
 	// Default: 29500
 	MasterPort int `yaml:"masterPort,omitempty"`
 
+	// MaxConcurrentChecks limits how many peers of a gang may run heavy checks at
+	// the same time. Slots are published in the gang ConfigMap and handed out in
+	// rank order. Default: 0 (unlimited)
+	MaxConcurrentChecks int `yaml:"maxConcurrentChecks,omitempty"`
+
+	// PeerStaleTimeout is how long a registered peer may go without a heartbeat
+	// before it is pruned from a gang that has not yet reached its expected count.
+	// Live peers refresh their heartbeat while their checks run. Accepts duration
+	// strings like "2m". Default: "" (disabled)
+	PeerStaleTimeout string `yaml:"peerStaleTimeout,omitempty"`
+
+	// PeerStaleTimeoutDuration is the parsed PeerStaleTimeout value. Set by Load().
+	PeerStaleTimeoutDuration time.Duration `yaml:"-"`
+
 	// ConfigMapMountPath is the path where gang ConfigMap is mounted in init containers.
 	// Default: /etc/preflight
 	ConfigMapMountPath string `yaml:"configMapMountPath,omitempty"`
@@ -307,6 +334,26 @@ func (c *FileConfig) validate() error {
 			c.InitContainerPlacement, PlacementPrepend, PlacementAppend)
 	}
 
+	for _, phase := range c.GangDiscovery.PodPhases {
+		switch phase {
+		case corev1.PodPending, corev1.PodRunning, corev1.PodSucceeded, corev1.PodFailed, corev1.PodUnknown:
+		default:
+			return fmt.Errorf("invalid gangDiscovery.podPhases entry %q", phase)
+		}
+	}
+
+	switch c.GangDiscovery.TerminatingPods {
+	case "", "Include", "Exclude":
+	default:
+		return fmt.Errorf("invalid gangDiscovery.terminatingPods %q: must be %q or %q",
+			c.GangDiscovery.TerminatingPods, "Include", "Exclude")
+	}
+
+	if c.GangCoordination.MaxConcurrentChecks < 0 {
+		return fmt.Errorf("gangCoordination.maxConcurrentChecks must not be negative, got %d",
+			c.GangCoordination.MaxConcurrentChecks)
+	}
+
 	if c.GangCoordination.Enabled {
 		timeout, err := time.ParseDuration(c.GangCoordination.Timeout)
 		if err != nil {
@@ -316,5 +363,20 @@ func (c *FileConfig) validate() error {
 		c.GangCoordination.TimeoutDuration = timeout
 	}
 
+	if c.GangCoordination.PeerStaleTimeout != "" {
+		staleTimeout, err := time.ParseDuration(c.GangCoordination.PeerStaleTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid gangCoordination.peerStaleTimeout %q: %w",
+				c.GangCoordination.PeerStaleTimeout, err)
+		}
+
+		if staleTimeout < 0 {
+			return fmt.Errorf("gangCoordination.peerStaleTimeout must not be negative, got %q",
+				c.GangCoordination.PeerStaleTimeout)
+		}
+
+		c.GangCoordination.PeerStaleTimeoutDuration = staleTimeout
+	}
+
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func writeYAML(t *testing.T, content string) string {
@@ -103,6 +104,74 @@ gangCoordination:
 		assert.Contains(t, err.Error(), "timeout")
 	})
 
+	t.Run("gang peer stale timeout", func(t *testing.T) {
+		path := writeYAML(t, `
+initContainers:
+  - name: preflight-dcgm-diag
+    image: dcgm:latest
+gangCoordination:
+  enabled: true
+  peerStaleTimeout: "2m"
+`)
+		cfg, err := Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, 2*time.Minute, cfg.GangCoordination.PeerStaleTimeoutDuration)
+	})
+
+	t.Run("gang invalid peer stale timeout", func(t *testing.T) {
+		path := writeYAML(t, `
+initContainers:
+  - name: preflight-dcgm-diag
+    image: dcgm:latest
+gangCoordination:
+  enabled: true
+  peerStaleTimeout: "soon"
+`)
+		_, err := Load(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "peerStaleTimeout")
+	})
+
+	t.Run("gang discovery pod phases", func(t *testing.T) {
+		path := writeYAML(t, `
+gangDiscovery:
+  podPhases: ["Running", "Succeeded"]
+`)
+		cfg, err := Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, []corev1.PodPhase{corev1.PodRunning, corev1.PodSucceeded}, cfg.GangDiscovery.PodPhases)
+	})
+
+	t.Run("gang discovery terminating pods", func(t *testing.T) {
+		path := writeYAML(t, `
+gangDiscovery:
+  terminatingPods: "Exclude"
+`)
+		cfg, err := Load(path)
+		require.NoError(t, err)
+		assert.Equal(t, "Exclude", cfg.GangDiscovery.TerminatingPods)
+	})
+
+	t.Run("gang discovery invalid terminating pods", func(t *testing.T) {
+		path := writeYAML(t, `
+gangDiscovery:
+  terminatingPods: "Maybe"
+`)
+		_, err := Load(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "terminatingPods")
+	})
+
+	t.Run("gang discovery invalid pod phase", func(t *testing.T) {
+		path := writeYAML(t, `
+gangDiscovery:
+  podPhases: ["Sleeping"]
+`)
+		_, err := Load(path)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "podPhases")
+	})
+
 	t.Run("invalid YAML", func(t *testing.T) {
 		path := writeYAML(t, `{invalid yaml: [`)
 		_, err := Load(path)
@@ -21,6 +21,7 @@ import (
 	"log/slog"
 	"strings"
 
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
 	"github.com/nvidia/nvsentinel/preflight/pkg/config"
 	"github.com/nvidia/nvsentinel/preflight/pkg/gang"
 	"github.com/nvidia/nvsentinel/preflight/pkg/gang/types"
@@ -88,9 +89,17 @@ func (c *GangController) podIPChangedPredicate() predicate.Predicate {
 				return false
 			}
 
-			return hasGangConfigVolume(newPod) &&
-				oldPod.Status.PodIP != newPod.Status.PodIP &&
-				newPod.Status.PodIP != ""
+			if !hasGangConfigVolume(newPod) || newPod.Status.PodIP == "" {
+				return false
+			}
+
+			if oldPod.Status.PodIP != newPod.Status.PodIP {
+				return true
+			}
+
+			// Release the check slot once the pod's preflight checks have finished.
+			return c.cfg.GangCoordination.MaxConcurrentChecks > 0 &&
+				!c.preflightChecksFinished(oldPod) && c.preflightChecksFinished(newPod)
 		},
 		DeleteFunc: func(_ event.DeleteEvent) bool {
 			return false
@@ -154,6 +163,16 @@ func (c *GangController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		return ctrl.Result{}, nil
 	}
 
+	if c.excludePeersOnUnavailableNodes(ctx, gangInfo, pod.Name) {
+		slog.Info("Pod is on a quarantined or draining node, not registering it as an active gang peer",
+			"pod", pod.Name,
+			"namespace", pod.Namespace,
+			"node", pod.Spec.NodeName,
+			"gangID", gangID)
+
+		return ctrl.Result{}, nil
+	}
+
 	// The webhook may have used a different gang ID (e.g., from a label
 	// fallback) than the one the controller discovers from the scheduler
 	// annotation. We must update the ConfigMap the webhook mounted, not
@@ -190,11 +209,114 @@ func (c *GangController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		"configMap", webhookCM,
 		"podIP", pod.Status.PodIP)
 
+	if c.cfg.GangCoordination.MaxConcurrentChecks > 0 && c.preflightChecksFinished(&pod) {
+		if err := c.coordinator.CompletePeerChecks(ctx, pod.Namespace, webhookCM, pod.Name); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to release check slot: %w", err)
+		}
+
+		slog.Info("Released gang check slot",
+			"pod", pod.Name,
+			"namespace", pod.Namespace,
+			"configMap", webhookCM)
+	}
+
 	c.cleanupOrphanedConfigMap(ctx, pod.Namespace, webhookCM, gangID)
 
+	// Keep re-registering while the checks run so the peer's heartbeat stays fresh and
+	// it is not pruned as stale by the other peers' registrations.
+	if staleTimeout := c.cfg.GangCoordination.PeerStaleTimeoutDuration; staleTimeout > 0 &&
+		!c.preflightChecksFinished(&pod) {
+		return ctrl.Result{RequeueAfter: staleTimeout / 3}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// preflightChecksFinished reports whether every preflight init container injected into
+// the pod has terminated.
+func (c *GangController) preflightChecksFinished(pod *corev1.Pod) bool {
+	checkNames := checkNamesFromPod(pod, c.cfg)
+	if checkNames == "" {
+		return false
+	}
+
+	terminated := make(map[string]bool, len(pod.Status.InitContainerStatuses))
+	for _, status := range pod.Status.InitContainerStatuses {
+		terminated[status.Name] = status.State.Terminated != nil
+	}
+
+	for name := range strings.SplitSeq(checkNames, ",") {
+		if !terminated[name] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// excludePeersOnUnavailableNodes removes peers whose node is cordoned or going through the
+// NVSentinel breakfix flow from the active gang, so the remaining peers can run preflight
+// among themselves. Returns true if the pod named podName was itself excluded.
+func (c *GangController) excludePeersOnUnavailableNodes(
+	ctx context.Context,
+	gangInfo *gang.GangInfo,
+	podName string,
+) bool {
+	unavailable := make(map[string]bool)
+
+	excluded := types.ExcludePeers(gangInfo, func(p gang.PeerInfo) bool {
+		if p.NodeName == "" {
+			return false
+		}
+
+		if isUnavailable, ok := unavailable[p.NodeName]; ok {
+			return isUnavailable
+		}
+
+		var node corev1.Node
+		if err := c.Get(ctx, client.ObjectKey{Name: p.NodeName}, &node); err != nil {
+			slog.Warn("Failed to get node of gang peer, keeping peer in the gang",
+				"pod", p.PodName,
+				"node", p.NodeName,
+				"error", err)
+
+			return false
+		}
+
+		unavailable[p.NodeName] = isNodeUnavailable(&node)
+
+		return unavailable[p.NodeName]
+	})
+
+	selfExcluded := false
+
+	for _, p := range excluded {
+		slog.Info("Excluded gang peer on unavailable node",
+			"gangID", gangInfo.GangID,
+			"pod", p.PodName,
+			"node", p.NodeName)
+
+		if p.PodName == podName {
+			selfExcluded = true
+		}
+	}
+
+	return selfExcluded
+}
+
+// isNodeUnavailable returns true if the node is cordoned or carries the NVSentinel state label,
+// i.e. it is quarantined, draining or being remediated. The warning state is not a breakfix
+// state: the node is only reported, not quarantined, and keeps running its workloads.
+func isNodeUnavailable(node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return true
+	}
+
+	state, inBreakfix := node.Labels[statemanager.NVSentinelStateLabelKey]
+
+	return inBreakfix && state != string(statemanager.WarningLabelValue)
+}
+
 // RegisterPod is called by the webhook when a pod is admitted that belongs to a gang.
 // It creates the ConfigMap immediately so schedulers (like KAI) that validate
 // ConfigMap existence before scheduling won't block.
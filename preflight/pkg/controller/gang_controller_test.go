@@ -19,6 +19,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nvidia/nvsentinel/commons/pkg/statemanager"
 	"github.com/nvidia/nvsentinel/preflight/pkg/config"
 	"github.com/nvidia/nvsentinel/preflight/pkg/gang"
 	"github.com/nvidia/nvsentinel/preflight/pkg/gang/coordinator"
@@ -725,3 +726,106 @@ func TestDeleteOrphanedConfigMap(t *testing.T) {
 		gc.deleteOrphanedConfigMap(ctx, "default", "nonexistent-cm")
 	})
 }
+
+func TestExcludePeersOnUnavailableNodes(t *testing.T) {
+	newNode := func(name string, unschedulable bool, labels map[string]string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Spec:       corev1.NodeSpec{Unschedulable: unschedulable},
+		}
+	}
+
+	newGangInfo := func() *types.GangInfo {
+		return &types.GangInfo{
+			GangID:           "gang-1",
+			ExpectedMinCount: 3,
+			Peers: []types.PeerInfo{
+				{PodName: "worker-0", NodeName: "node-healthy", Namespace: "default"},
+				{PodName: "worker-1", NodeName: "node-cordoned", Namespace: "default"},
+				{PodName: "worker-2", NodeName: "node-healthy-2", Namespace: "default"},
+			},
+		}
+	}
+
+	t.Run("peer on cordoned node is excluded from the active gang", func(t *testing.T) {
+		fc := fake.NewClientBuilder().WithObjects(
+			newNode("node-healthy", false, nil),
+			newNode("node-cordoned", true, nil),
+			newNode("node-healthy-2", false, nil),
+		).Build()
+
+		gc := &GangController{Client: fc}
+		gangInfo := newGangInfo()
+
+		selfExcluded := gc.excludePeersOnUnavailableNodes(context.Background(), gangInfo, "worker-0")
+
+		assert.False(t, selfExcluded)
+		assert.Equal(t, 2, gangInfo.ExpectedMinCount)
+		require.Len(t, gangInfo.Peers, 2)
+		assert.Equal(t, "worker-0", gangInfo.Peers[0].PodName)
+		assert.Equal(t, "worker-2", gangInfo.Peers[1].PodName)
+		require.Len(t, gangInfo.ExcludedPeers, 1)
+		assert.Equal(t, "worker-1", gangInfo.ExcludedPeers[0].PodName)
+	})
+
+	t.Run("peer on draining node is excluded", func(t *testing.T) {
+		fc := fake.NewClientBuilder().WithObjects(
+			newNode("node-healthy", false, nil),
+			newNode("node-cordoned", false, map[string]string{
+				statemanager.NVSentinelStateLabelKey: string(statemanager.DrainingLabelValue),
+			}),
+			newNode("node-healthy-2", false, nil),
+		).Build()
+
+		gc := &GangController{Client: fc}
+		gangInfo := newGangInfo()
+
+		gc.excludePeersOnUnavailableNodes(context.Background(), gangInfo, "worker-0")
+
+		require.Len(t, gangInfo.ExcludedPeers, 1)
+		assert.Equal(t, "worker-1", gangInfo.ExcludedPeers[0].PodName)
+	})
+
+	t.Run("peer on node in warning state is kept", func(t *testing.T) {
+		fc := fake.NewClientBuilder().WithObjects(
+			newNode("node-healthy", false, nil),
+			newNode("node-cordoned", false, map[string]string{
+				statemanager.NVSentinelStateLabelKey: string(statemanager.WarningLabelValue),
+			}),
+			newNode("node-healthy-2", false, nil),
+		).Build()
+
+		gc := &GangController{Client: fc}
+		gangInfo := newGangInfo()
+
+		assert.False(t, gc.excludePeersOnUnavailableNodes(context.Background(), gangInfo, "worker-1"))
+		assert.Len(t, gangInfo.Peers, 3)
+		assert.Empty(t, gangInfo.ExcludedPeers)
+		assert.Equal(t, 3, gangInfo.ExpectedMinCount)
+	})
+
+	t.Run("reports when the reconciled pod itself is excluded", func(t *testing.T) {
+		fc := fake.NewClientBuilder().WithObjects(
+			newNode("node-healthy", false, nil),
+			newNode("node-cordoned", true, nil),
+			newNode("node-healthy-2", false, nil),
+		).Build()
+
+		gc := &GangController{Client: fc}
+
+		assert.True(t, gc.excludePeersOnUnavailableNodes(context.Background(), newGangInfo(), "worker-1"))
+	})
+
+	t.Run("peer is kept when its node cannot be fetched", func(t *testing.T) {
+		fc := fake.NewClientBuilder().WithObjects(newNode("node-healthy", false, nil)).Build()
+
+		gc := &GangController{Client: fc}
+		gangInfo := newGangInfo()
+
+		gc.excludePeersOnUnavailableNodes(context.Background(), gangInfo, "worker-0")
+
+		assert.Len(t, gangInfo.Peers, 3)
+		assert.Empty(t, gangInfo.ExcludedPeers)
+		assert.Equal(t, 3, gangInfo.ExpectedMinCount)
+	})
+}
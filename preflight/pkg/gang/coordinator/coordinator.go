@@ -23,15 +23,19 @@ import (
 	"log/slog"
 	"math"
 	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/nvidia/nvsentinel/preflight/pkg/gang/types"
+	"github.com/nvidia/nvsentinel/preflight/pkg/metrics"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -43,6 +47,17 @@ var (
 	repeatedDashes = regexp.MustCompile(`-{2,}`)
 )
 
+// configMapUpdateBackoff is the backoff for gang ConfigMap read-modify-write cycles.
+// All peers of a gang register at roughly the same time, so it allows more attempts
+// than retry.DefaultBackoff before giving up.
+var configMapUpdateBackoff = wait.Backoff{
+	Steps:    10,
+	Duration: 10 * time.Millisecond,
+	Factor:   2.0,
+	Jitter:   0.5,
+	Cap:      2 * time.Second,
+}
+
 const (
 	// ConfigMapPrefix is the prefix for all preflight gang ConfigMaps.
 	ConfigMapPrefix = "preflight-"
@@ -65,6 +80,36 @@ const (
 	// DataKeyPeers is the ConfigMap data key for the peer list.
 	DataKeyPeers = "peers"
 
+	// DataKeyRanks is the ConfigMap data key for the frozen rank assignment.
+	// It is written once, when the gang first reaches its expected count.
+	// Format: "podName;rank" per line.
+	DataKeyRanks = "ranks"
+
+	// DataKeyExcludedPeers is the ConfigMap data key for peers left out of the active gang
+	// because their node is quarantined or draining. Format: one pod name per line.
+	DataKeyExcludedPeers = "excluded_peers"
+
+	// DataKeyGangSize is the ConfigMap data key for the gang size including excluded peers.
+	// It is only set while peers are excluded, when expected_count is lower than the gang size.
+	DataKeyGangSize = "gang_size"
+
+	// DataKeyMaxConcurrentChecks is the ConfigMap data key for the maximum number of
+	// peers allowed to run heavy checks at once. Absent when there is no limit.
+	DataKeyMaxConcurrentChecks = "max_concurrent_checks"
+
+	// DataKeyActivePeers is the ConfigMap data key for the peers currently holding a
+	// check slot. Format: one pod name per line.
+	DataKeyActivePeers = "active_peers"
+
+	// DataKeyCompletedPeers is the ConfigMap data key for the peers that finished their
+	// checks and released their slot. Format: one pod name per line.
+	DataKeyCompletedPeers = "completed_peers"
+
+	// DataKeyHeartbeats is the ConfigMap data key for the last time each peer was seen
+	// registering. Absent when stale peer pruning is disabled.
+	// Format: "podName;RFC3339 timestamp" per line.
+	DataKeyHeartbeats = "heartbeats"
+
 	// DataKeyGangID is the ConfigMap data key for the full gang ID.
 	// This stores the unsanitized gang ID since labels have a 63-char limit.
 	DataKeyGangID = "gang_id"
@@ -78,6 +123,15 @@ type CoordinatorConfig struct {
 	// MasterPort is the port used for PyTorch distributed TCP bootstrap.
 	// Default: 29500
 	MasterPort int
+
+	// MaxConcurrentChecks limits how many peers of a gang run heavy checks at once.
+	// Default: 0 (unlimited)
+	MaxConcurrentChecks int
+
+	// PeerStaleTimeout is how long a peer may go without re-registering before it is
+	// pruned from a gang that has not yet frozen its ranks.
+	// Default: 0 (disabled)
+	PeerStaleTimeout time.Duration
 }
 
 func DefaultCoordinatorConfig() CoordinatorConfig {
@@ -91,6 +145,9 @@ func DefaultCoordinatorConfig() CoordinatorConfig {
 type Coordinator struct {
 	client client.Client
 	config CoordinatorConfig
+	now    func() time.Time
+
+	quorumPollInterval time.Duration
 }
 
 func NewCoordinator(c client.Client, config CoordinatorConfig) *Coordinator {
@@ -101,6 +158,9 @@ func NewCoordinator(c client.Client, config CoordinatorConfig) *Coordinator {
 	return &Coordinator{
 		client: c,
 		config: config,
+		now:    time.Now,
+
+		quorumPollInterval: DefaultQuorumPollInterval,
 	}
 }
 
@@ -187,6 +247,10 @@ func (c *Coordinator) EnsureConfigMap(
 		return fmt.Errorf("failed to create ConfigMap %s: %w", configMapName, err)
 	}
 
+	if err == nil {
+		metrics.GangConfigMapCreates.Inc()
+	}
+
 	slog.Info("Created gang ConfigMap",
 		"configMap", configMapName,
 		"namespace", namespace,
@@ -203,7 +267,9 @@ func (c *Coordinator) RegisterPeer(
 	gangInfo *types.GangInfo,
 	peer types.PeerInfo,
 ) error {
-	if err := c.EnsureConfigMap(ctx, namespace, gangInfo.GangID, gangInfo.ExpectedMinCount); err != nil {
+	// Excluded peers still count towards the gang size the ConfigMap starts from.
+	gangSize := gangInfo.ExpectedMinCount + len(gangInfo.ExcludedPeers)
+	if err := c.EnsureConfigMap(ctx, namespace, gangInfo.GangID, gangSize); err != nil {
 		return fmt.Errorf("failed to ensure ConfigMap: %w", err)
 	}
 
@@ -218,7 +284,7 @@ func (c *Coordinator) RegisterPeer(
 
 	livePodNames := livePodNamesFromPeers(gangInfo.Peers)
 
-	if err := c.updateConfigMap(ctx, namespace, configMapName, gangInfo.ExpectedMinCount, peer, livePodNames); err != nil {
+	if err := c.updateConfigMap(ctx, namespace, configMapName, gangInfo, peer, livePodNames); err != nil {
 		return fmt.Errorf("failed to update ConfigMap: %w", err)
 	}
 
@@ -256,7 +322,7 @@ func (c *Coordinator) RegisterPeerInConfigMap(
 
 	livePodNames := livePodNamesFromPeers(gangInfo.Peers)
 
-	if err := c.updateConfigMap(ctx, namespace, configMapName, gangInfo.ExpectedMinCount, peer, livePodNames); err != nil {
+	if err := c.updateConfigMap(ctx, namespace, configMapName, gangInfo, peer, livePodNames); err != nil {
 		return fmt.Errorf("failed to update ConfigMap: %w", err)
 	}
 
@@ -269,34 +335,163 @@ func (c *Coordinator) RegisterPeerInConfigMap(
 	return nil
 }
 
-// updateConfigMap updates an existing ConfigMap, retrying on conflict.
+// updateConfigMap adds the peer to an existing ConfigMap.
 func (c *Coordinator) updateConfigMap(
 	ctx context.Context,
 	namespace string,
 	configMapName string,
-	expectedCount int,
+	gangInfo *types.GangInfo,
 	peer types.PeerInfo,
 	livePodNames map[string]bool,
 ) error {
-	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
-		cm := &corev1.ConfigMap{}
+	var peersBefore int
+
+	cm, err := c.updateConfigMapWithRetry(ctx, namespace, configMapName, func(cm *corev1.ConfigMap) {
+		setExpectedCount(cm, gangInfo)
+		setExcludedPeers(cm, gangInfo.ExcludedPeers)
+
+		c.pruneStalePeers(cm, peer.PodName)
+
+		peersBefore = len(ParsePeers(cm.Data[DataKeyPeers]))
+
+		c.addPeerToConfigMap(cm, peer, livePodNames)
+		c.recordHeartbeat(cm, peer)
+		c.updateMasterAddr(cm)
+		freezeRanks(cm)
+		c.assignCheckSlots(cm)
+	})
+	if err != nil {
+		return err
+	}
+
+	observeRendezvous(cm, peersBefore)
+
+	return nil
+}
+
+// updateConfigMapWithRetry applies mutate to the latest version of the ConfigMap and
+// writes it back. When a concurrent writer wins the race, the ConfigMap is re-read and
+// mutate is applied again, so no writer's changes are lost.
+func (c *Coordinator) updateConfigMapWithRetry(
+	ctx context.Context,
+	namespace string,
+	configMapName string,
+	mutate func(cm *corev1.ConfigMap),
+) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+
+	err := retry.RetryOnConflict(configMapUpdateBackoff, func() error {
+		cm = &corev1.ConfigMap{}
 		if err := c.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapName}, cm); err != nil {
 			return fmt.Errorf("failed to get ConfigMap %s: %w", configMapName, err)
 		}
 
-		// Update expected_count if it was 0 (skeleton) and we now have the real value
-		if expectedCount > 0 {
-			currentCount, _ := strconv.Atoi(cm.Data[DataKeyExpectedCount])
-			if currentCount == 0 {
-				cm.Data[DataKeyExpectedCount] = strconv.Itoa(expectedCount)
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+
+		mutate(cm)
+
+		if err := c.client.Update(ctx, cm); err != nil {
+			if errors.IsConflict(err) {
+				metrics.GangConfigMapConflicts.Inc()
 			}
+
+			return err
 		}
 
-		c.addPeerToConfigMap(cm, peer, livePodNames)
-		c.updateMasterAddr(cm)
+		metrics.GangConfigMapUpdates.Inc()
 
-		return c.client.Update(ctx, cm)
+		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cm, nil
+}
+
+// observeRendezvous records the rendezvous wait time when an update brings the
+// gang to its expected peer count for the first time.
+func observeRendezvous(cm *corev1.ConfigMap, peersBefore int) {
+	expectedCount, _ := strconv.Atoi(cm.Data[DataKeyExpectedCount])
+	if expectedCount == 0 || cm.CreationTimestamp.IsZero() {
+		return
+	}
+
+	peersAfter := len(ParsePeers(cm.Data[DataKeyPeers]))
+	if peersBefore < expectedCount && peersAfter >= expectedCount {
+		metrics.GangRendezvousWaitDuration.Observe(time.Since(cm.CreationTimestamp.Time).Seconds())
+	}
+}
+
+// FreezeRanks writes the full rank map to the gang ConfigMap once the gang is
+// complete, so every peer reads the same assignment instead of recomputing it
+// from a peer list that may still be changing. It returns the frozen rank map,
+// which is empty while the gang has not reached its expected count. Once written, the
+// rank map is not changed by later registrations.
+func (c *Coordinator) FreezeRanks(ctx context.Context, namespace, gangID string) (map[string]int, error) {
+	configMapName := ConfigMapName(gangID)
+
+	cm, err := c.updateConfigMapWithRetry(ctx, namespace, configMapName, freezeRanks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to freeze ranks in ConfigMap %s: %w", configMapName, err)
+	}
+
+	return ParseRanks(cm.Data[DataKeyRanks]), nil
+}
+
+// freezeRanks records the rank of every peer in the ConfigMap if the gang is
+// complete and no rank map has been written yet.
+func freezeRanks(cm *corev1.ConfigMap) {
+	if cm.Data[DataKeyRanks] != "" {
+		return
+	}
+
+	expectedCount, _ := strconv.Atoi(cm.Data[DataKeyExpectedCount])
+	peers := ParsePeers(cm.Data[DataKeyPeers])
+
+	if expectedCount == 0 || len(peers) < expectedCount {
+		return
+	}
+
+	topology := AssignTopology(peers, 0)
+
+	lines := make([]string, 0, len(peers))
+	for _, p := range peers {
+		lines = append(lines, fmt.Sprintf("%s;%d", p.PodName, topology.RankOf[p.PodName]))
+	}
+
+	sort.Strings(lines)
+
+	cm.Data[DataKeyRanks] = strings.Join(lines, "\n")
+
+	slog.Info("Froze gang rank assignment",
+		"configMap", cm.Name,
+		"namespace", cm.Namespace,
+		"peers", len(peers))
+}
+
+// ParseRanks parses the frozen rank map from a ConfigMap.
+// Format: "podName;rank" per line. Malformed lines are skipped.
+func ParseRanks(ranksData string) map[string]int {
+	ranks := make(map[string]int)
+
+	for line := range strings.SplitSeq(strings.TrimSpace(ranksData), "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), ";", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		rank, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			continue
+		}
+
+		ranks[strings.TrimSpace(parts[0])] = rank
+	}
+
+	return ranks
 }
 
 // GetGangConfigMap retrieves the gang ConfigMap.
@@ -345,20 +540,54 @@ func ParsePeers(peersData string) []types.PeerInfo {
 
 // GetRank returns the rank of a pod in the gang based on alphabetical ordering.
 func GetRank(podName string, peers []types.PeerInfo) int {
-	names := make([]string, len(peers))
-	for i, p := range peers {
-		names[i] = p.PodName
-	}
+	return slices.IndexFunc(types.RankOrder(peers), func(p types.PeerInfo) bool {
+		return p.PodName == podName
+	})
+}
 
-	sort.Strings(names)
+// Topology is the distributed training layout of a gang.
+type Topology struct {
+	// WorldSize is the number of peers in the gang.
+	WorldSize int
+	// MasterAddr is the PodIP of the rank 0 peer; empty if it has no IP yet.
+	MasterAddr string
+	// MasterPort is the port the rank 0 peer listens on for bootstrap.
+	MasterPort int
+	// RankOf maps each peer's pod name to its rank.
+	RankOf map[string]int
+}
 
-	for i, name := range names {
-		if name == podName {
-			return i
-		}
+// AssignTopology computes the gang layout from its peers. Ranks follow types.RankOrder, so the
+// master is the alphabetically first pod regardless of peer order.
+func AssignTopology(peers []types.PeerInfo, masterPort int) Topology {
+	sorted := types.RankOrder(peers)
+
+	topology := Topology{
+		WorldSize:  len(sorted),
+		MasterPort: masterPort,
+		RankOf:     make(map[string]int, len(sorted)),
 	}
 
-	return -1
+	for i, p := range sorted {
+		topology.RankOf[p.PodName] = i
+	}
+
+	topology.MasterAddr, _ = (&types.GangInfo{Peers: sorted}).MasterEndpoint()
+
+	return topology
+}
+
+// GangInfoFromConfigMap returns the gang recorded in a gang ConfigMap, including its frozen
+// ranks once they have been written.
+func GangInfoFromConfigMap(cm *corev1.ConfigMap) *types.GangInfo {
+	expectedCount, _ := strconv.Atoi(cm.Data[DataKeyExpectedCount])
+
+	return &types.GangInfo{
+		GangID:           cm.Data[DataKeyGangID],
+		ExpectedMinCount: expectedCount,
+		Peers:            ParsePeers(cm.Data[DataKeyPeers]),
+		Ranks:            ParseRanks(cm.Data[DataKeyRanks]),
+	}
 }
 
 // createConfigMap creates a new ConfigMap for gang coordination.
@@ -428,20 +657,163 @@ func (c *Coordinator) addPeerToConfigMap(cm *corev1.ConfigMap, peer types.PeerIn
 		existingPeers = append(existingPeers, peer)
 	}
 
+	setPeers(cm, existingPeers)
+}
+
+// setPeers writes the peer list sorted by name, with each peer's rank being its index.
+func setPeers(cm *corev1.ConfigMap, peers []types.PeerInfo) {
 	// Sort peers by name for consistent ordering and rank assignment
-	sort.Slice(existingPeers, func(i, j int) bool {
-		return existingPeers[i].PodName < existingPeers[j].PodName
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].PodName < peers[j].PodName
 	})
 
 	// Serialize peers with rank (index after sorting)
 	var lines []string
-	for rank, p := range existingPeers {
+	for rank, p := range peers {
 		lines = append(lines, fmt.Sprintf("%s;%s;%d;%s", p.PodName, p.PodIP, rank, p.CheckNames))
 	}
 
 	cm.Data[DataKeyPeers] = strings.Join(lines, "\n")
 }
 
+// setExpectedCount recomputes expected_count as the gang size minus the currently excluded
+// peers, so it drops while peers are excluded and rises again when they return. A skeleton
+// ConfigMap takes the gang size from the discovered gang; otherwise the count already in the
+// ConfigMap is kept.
+func setExpectedCount(cm *corev1.ConfigMap, gangInfo *types.GangInfo) {
+	if gangInfo.ExpectedMinCount <= 0 {
+		return
+	}
+
+	gangSize, _ := strconv.Atoi(cm.Data[DataKeyGangSize])
+	if gangSize == 0 {
+		gangSize, _ = strconv.Atoi(cm.Data[DataKeyExpectedCount])
+	}
+
+	if gangSize == 0 {
+		gangSize = gangInfo.ExpectedMinCount + len(gangInfo.ExcludedPeers)
+	}
+
+	if len(gangInfo.ExcludedPeers) == 0 {
+		delete(cm.Data, DataKeyGangSize)
+		cm.Data[DataKeyExpectedCount] = strconv.Itoa(gangSize)
+
+		return
+	}
+
+	cm.Data[DataKeyGangSize] = strconv.Itoa(gangSize)
+	cm.Data[DataKeyExpectedCount] = strconv.Itoa(max(gangSize-len(gangInfo.ExcludedPeers), len(gangInfo.Peers)))
+}
+
+// setExcludedPeers records the pod names of excluded peers, or clears the key when there are none.
+func setExcludedPeers(cm *corev1.ConfigMap, excluded []types.PeerInfo) {
+	if len(excluded) == 0 {
+		delete(cm.Data, DataKeyExcludedPeers)
+		return
+	}
+
+	names := make([]string, 0, len(excluded))
+	for _, p := range excluded {
+		names = append(names, p.PodName)
+	}
+
+	sort.Strings(names)
+
+	cm.Data[DataKeyExcludedPeers] = strings.Join(names, "\n")
+}
+
+// CompletePeerChecks marks the peer as done with its checks, releasing its slot
+// to the next waiting peer when a concurrency limit is configured.
+func (c *Coordinator) CompletePeerChecks(ctx context.Context, namespace, configMapName, podName string) error {
+	if c.config.MaxConcurrentChecks <= 0 {
+		return nil
+	}
+
+	_, err := c.updateConfigMapWithRetry(ctx, namespace, configMapName, func(cm *corev1.ConfigMap) {
+		completed := parseNameList(cm.Data[DataKeyCompletedPeers])
+		if !slices.Contains(completed, podName) {
+			completed = append(completed, podName)
+			sort.Strings(completed)
+			cm.Data[DataKeyCompletedPeers] = strings.Join(completed, "\n")
+		}
+
+		c.assignCheckSlots(cm)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete checks for pod %s in ConfigMap %s: %w", podName, configMapName, err)
+	}
+
+	return nil
+}
+
+// assignCheckSlots hands out check slots to registered peers in rank order so that no
+// more than MaxConcurrentChecks peers are active at once. Peers already holding a slot
+// keep it until they complete. Without a limit the slot keys are removed.
+func (c *Coordinator) assignCheckSlots(cm *corev1.ConfigMap) {
+	limit := c.config.MaxConcurrentChecks
+	if limit <= 0 {
+		delete(cm.Data, DataKeyMaxConcurrentChecks)
+		delete(cm.Data, DataKeyActivePeers)
+		delete(cm.Data, DataKeyCompletedPeers)
+
+		return
+	}
+
+	cm.Data[DataKeyMaxConcurrentChecks] = strconv.Itoa(limit)
+
+	peers := ParsePeers(cm.Data[DataKeyPeers])
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].PodName < peers[j].PodName
+	})
+
+	registered := make(map[string]bool, len(peers))
+	for _, p := range peers {
+		registered[p.PodName] = true
+	}
+
+	completed := make(map[string]bool)
+	for _, name := range parseNameList(cm.Data[DataKeyCompletedPeers]) {
+		completed[name] = true
+	}
+
+	active := make([]string, 0, limit)
+
+	for _, name := range parseNameList(cm.Data[DataKeyActivePeers]) {
+		if registered[name] && !completed[name] && len(active) < limit {
+			active = append(active, name)
+		}
+	}
+
+	for _, p := range peers {
+		if len(active) >= limit {
+			break
+		}
+
+		if completed[p.PodName] || slices.Contains(active, p.PodName) {
+			continue
+		}
+
+		active = append(active, p.PodName)
+	}
+
+	sort.Strings(active)
+
+	cm.Data[DataKeyActivePeers] = strings.Join(active, "\n")
+}
+
+// parseNameList parses a newline-separated list of pod names, skipping blank lines.
+func parseNameList(data string) []string {
+	var names []string
+
+	for line := range strings.SplitSeq(strings.TrimSpace(data), "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
 // livePodNamesFromPeers builds a set of pod names from the discovered peers.
 // Returns nil when peers is empty so callers can distinguish "no live data
 // available" (nil → skip pruning) from "discovered peers but none matched"
@@ -460,20 +832,10 @@ func livePodNamesFromPeers(peers []types.PeerInfo) map[string]bool {
 }
 
 // updateMasterAddr updates the master address in the ConfigMap.
-// Master is the pod with rank 0 (first alphabetically).
+// Master is the pod with rank 0: the frozen rank 0 once ranks are frozen, otherwise the
+// alphabetically first pod.
 func (c *Coordinator) updateMasterAddr(cm *corev1.ConfigMap) {
-	peers := ParsePeers(cm.Data[DataKeyPeers])
-	if len(peers) == 0 {
-		return
-	}
-
-	// Sort by name to determine rank 0
-	sort.Slice(peers, func(i, j int) bool {
-		return peers[i].PodName < peers[j].PodName
-	})
-
-	// Rank 0 is the master
-	if peers[0].PodIP != "" {
-		cm.Data[DataKeyMasterAddr] = peers[0].PodIP
+	if masterAddr, ok := GangInfoFromConfigMap(cm).MasterEndpoint(); ok {
+		cm.Data[DataKeyMasterAddr] = masterAddr
 	}
 }
@@ -16,17 +16,24 @@ package coordinator
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/nvidia/nvsentinel/preflight/pkg/gang/types"
+	"github.com/nvidia/nvsentinel/preflight/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func TestConfigMapName(t *testing.T) {
@@ -368,6 +375,46 @@ func TestGetRank(t *testing.T) {
 	}
 }
 
+func TestAssignTopology(t *testing.T) {
+	peers := []types.PeerInfo{
+		{PodName: "worker-2", PodIP: "10.0.0.3"},
+		{PodName: "worker-0", PodIP: "10.0.0.1"},
+		{PodName: "worker-1", PodIP: "10.0.0.2"},
+	}
+
+	want := map[string]int{"worker-0": 0, "worker-1": 1, "worker-2": 2}
+
+	// Every ordering of the peers yields the same topology.
+	orders := [][]int{{0, 1, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	for _, order := range orders {
+		shuffled := []types.PeerInfo{peers[order[0]], peers[order[1]], peers[order[2]]}
+
+		topology := AssignTopology(shuffled, DefaultMasterPort)
+
+		assert.Equal(t, 3, topology.WorldSize)
+		assert.Equal(t, "10.0.0.1", topology.MasterAddr, "master must be the alphabetically first pod")
+		assert.Equal(t, DefaultMasterPort, topology.MasterPort)
+		assert.Equal(t, want, topology.RankOf)
+
+		for name, rank := range topology.RankOf {
+			assert.Equal(t, GetRank(name, shuffled), rank)
+		}
+	}
+
+	t.Run("does not reorder the input", func(t *testing.T) {
+		input := slices.Clone(peers)
+		AssignTopology(input, DefaultMasterPort)
+		assert.Equal(t, peers, input)
+	})
+
+	t.Run("empty gang", func(t *testing.T) {
+		topology := AssignTopology(nil, DefaultMasterPort)
+		assert.Equal(t, 0, topology.WorldSize)
+		assert.Empty(t, topology.MasterAddr)
+		assert.Empty(t, topology.RankOf)
+	})
+}
+
 // --- Tests with fake client ---
 
 func newFakeCoordinator(objects ...client.Object) *Coordinator {
@@ -523,9 +570,328 @@ func TestRegisterPeer(t *testing.T) {
 	})
 }
 
+// TestRegisterPeerConflictMetric covers a concurrent writer updating the
+// ConfigMap between our read and write: the conflict is counted and the
+// retried update keeps the other writer's peer.
+func TestRegisterPeerConflictMetric(t *testing.T) {
+	ctx := context.Background()
+	collided := false
+
+	c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Update: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if !collided {
+				collided = true
+
+				competing := &corev1.ConfigMap{}
+				if err := cl.Get(ctx, client.ObjectKeyFromObject(obj), competing); err != nil {
+					return err
+				}
+
+				competing.Data[DataKeyPeers] = "pod-b;10.0.0.2;0;"
+				if err := cl.Update(ctx, competing); err != nil {
+					return err
+				}
+			}
+
+			return cl.Update(ctx, obj, opts...)
+		},
+	}).Build()
+	coord := NewCoordinator(c, DefaultCoordinatorConfig())
+
+	conflictsBefore := testutil.ToFloat64(metrics.GangConfigMapConflicts)
+	updatesBefore := testutil.ToFloat64(metrics.GangConfigMapUpdates)
+
+	gangInfo := &types.GangInfo{GangID: "conflict-gang", ExpectedMinCount: 2}
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-a", PodIP: "10.0.0.1"}))
+
+	assert.Equal(t, conflictsBefore+1, testutil.ToFloat64(metrics.GangConfigMapConflicts))
+	assert.Equal(t, updatesBefore+1, testutil.ToFloat64(metrics.GangConfigMapUpdates))
+
+	cm := getConfigMap(t, coord.client, "default", ConfigMapName("conflict-gang"))
+	peers := ParsePeers(cm.Data[DataKeyPeers])
+	require.Len(t, peers, 2, "retried update should keep the competing peer")
+	assert.Equal(t, "pod-a", peers[0].PodName)
+	assert.Equal(t, "pod-b", peers[1].PodName)
+}
+
+// TestRegisterPeerConcurrent covers peers of one gang registering at the same
+// time: every registration must survive the resulting update conflicts.
+func TestRegisterPeerConcurrent(t *testing.T) {
+	const peerCount = 16
+
+	coord := newFakeCoordinator()
+	ctx := context.Background()
+	gangInfo := &types.GangInfo{GangID: "concurrent-gang", ExpectedMinCount: peerCount}
+
+	require.NoError(t, coord.EnsureConfigMap(ctx, "default", gangInfo.GangID, peerCount))
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, peerCount)
+
+	for i := range peerCount {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			peer := types.PeerInfo{PodName: fmt.Sprintf("pod-%02d", i), PodIP: fmt.Sprintf("10.0.0.%d", i+1)}
+			errs <- coord.RegisterPeer(ctx, "default", gangInfo, peer)
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	cm := getConfigMap(t, coord.client, "default", ConfigMapName(gangInfo.GangID))
+	peers := ParsePeers(cm.Data[DataKeyPeers])
+	require.Len(t, peers, peerCount, "no registration should be lost")
+
+	for i, p := range peers {
+		assert.Equal(t, fmt.Sprintf("pod-%02d", i), p.PodName)
+	}
+
+	assert.Equal(t, "10.0.0.1", cm.Data[DataKeyMasterAddr])
+}
+
+// TestFreezeRanks covers the frozen rank map: not written before the gang is
+// complete, matches GetRank once complete, and unchanged by later registrations.
+func TestFreezeRanks(t *testing.T) {
+	coord := newFakeCoordinator()
+	ctx := context.Background()
+	gangInfo := &types.GangInfo{GangID: "rank-gang", ExpectedMinCount: 3}
+
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-c", PodIP: "10.0.0.3"}))
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-a", PodIP: "10.0.0.1"}))
+
+	ranks, err := coord.FreezeRanks(ctx, "default", gangInfo.GangID)
+	require.NoError(t, err)
+	assert.Empty(t, ranks, "ranks must not be frozen before the gang is complete")
+
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-b", PodIP: "10.0.0.2"}))
+
+	cm := getConfigMap(t, coord.client, "default", ConfigMapName(gangInfo.GangID))
+	peers := ParsePeers(cm.Data[DataKeyPeers])
+	frozen := ParseRanks(cm.Data[DataKeyRanks])
+	require.Len(t, frozen, 3)
+
+	for _, p := range peers {
+		assert.Equal(t, GetRank(p.PodName, peers), frozen[p.PodName], "rank mismatch for %s", p.PodName)
+	}
+
+	ranks, err = coord.FreezeRanks(ctx, "default", gangInfo.GangID)
+	require.NoError(t, err)
+	assert.Equal(t, frozen, ranks)
+
+	// A late peer that sorts first would shift every live rank; the frozen map must not change.
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-0", PodIP: "10.0.0.4"}))
+
+	cm = getConfigMap(t, coord.client, "default", ConfigMapName(gangInfo.GangID))
+	assert.Equal(t, frozen, ParseRanks(cm.Data[DataKeyRanks]))
+}
+
+func TestRegisterPeerWithExcludedPeers(t *testing.T) {
+	coord := newFakeCoordinator()
+	ctx := context.Background()
+
+	peerA := types.PeerInfo{PodName: "pod-a", PodIP: "10.0.0.1", NodeName: "node-a"}
+	peerB := types.PeerInfo{PodName: "pod-b", PodIP: "10.0.0.2", NodeName: "node-b"}
+	peerC := types.PeerInfo{PodName: "pod-c", PodIP: "10.0.0.3", NodeName: "node-c"}
+
+	full := &types.GangInfo{GangID: "partial-gang", ExpectedMinCount: 3, Peers: []types.PeerInfo{peerA, peerB, peerC}}
+	require.NoError(t, coord.RegisterPeer(ctx, "default", full, peerB))
+
+	// pod-b's node gets quarantined; the remaining peers coordinate without it.
+	partial := &types.GangInfo{GangID: "partial-gang", ExpectedMinCount: 3, Peers: []types.PeerInfo{peerA, peerB, peerC}}
+	types.ExcludePeers(partial, func(p types.PeerInfo) bool { return p.NodeName == "node-b" })
+
+	require.NoError(t, coord.RegisterPeer(ctx, "default", partial, peerA))
+	require.NoError(t, coord.RegisterPeer(ctx, "default", partial, peerC))
+
+	cm := getConfigMap(t, coord.client, "default", ConfigMapName(partial.GangID))
+	assert.Equal(t, "2", cm.Data[DataKeyExpectedCount])
+	assert.Equal(t, "pod-b", cm.Data[DataKeyExcludedPeers])
+
+	peers := ParsePeers(cm.Data[DataKeyPeers])
+	require.Len(t, peers, 2)
+	assert.Equal(t, "pod-a", peers[0].PodName)
+	assert.Equal(t, "pod-c", peers[1].PodName)
+	assert.Len(t, ParseRanks(cm.Data[DataKeyRanks]), 2, "ranks should freeze once the remaining peers registered")
+}
+
+func TestRegisterPeerAfterExcludedPeerReturns(t *testing.T) {
+	coord := newFakeCoordinator()
+	ctx := context.Background()
+
+	peerA := types.PeerInfo{PodName: "pod-a", PodIP: "10.0.0.1", NodeName: "node-a"}
+	peerB := types.PeerInfo{PodName: "pod-b", PodIP: "10.0.0.2", NodeName: "node-b"}
+	peerC := types.PeerInfo{PodName: "pod-c", PodIP: "10.0.0.3", NodeName: "node-c"}
+
+	// pod-b's node is quarantined when pod-a registers.
+	partial := &types.GangInfo{GangID: "returning-gang", ExpectedMinCount: 3, Peers: []types.PeerInfo{peerA, peerB, peerC}}
+	types.ExcludePeers(partial, func(p types.PeerInfo) bool { return p.NodeName == "node-b" })
+	require.NoError(t, coord.RegisterPeer(ctx, "default", partial, peerA))
+
+	cm := getConfigMap(t, coord.client, "default", ConfigMapName(partial.GangID))
+	assert.Equal(t, "2", cm.Data[DataKeyExpectedCount])
+	assert.Equal(t, "3", cm.Data[DataKeyGangSize])
+	assert.Equal(t, "pod-b", cm.Data[DataKeyExcludedPeers])
+
+	// The node is released before the gang completes, so pod-b is part of the gang again.
+	full := &types.GangInfo{GangID: "returning-gang", ExpectedMinCount: 3, Peers: []types.PeerInfo{peerA, peerB, peerC}}
+	require.NoError(t, coord.RegisterPeer(ctx, "default", full, peerB))
+
+	cm = getConfigMap(t, coord.client, "default", ConfigMapName(full.GangID))
+	assert.Equal(t, "3", cm.Data[DataKeyExpectedCount], "expected_count must rise again when the peer returns")
+	assert.NotContains(t, cm.Data, DataKeyExcludedPeers)
+	assert.NotContains(t, cm.Data, DataKeyGangSize)
+	assert.Empty(t, cm.Data[DataKeyRanks], "ranks must not freeze before pod-c registers")
+
+	require.NoError(t, coord.RegisterPeer(ctx, "default", full, peerC))
+
+	cm = getConfigMap(t, coord.client, "default", ConfigMapName(full.GangID))
+	assert.Len(t, ParseRanks(cm.Data[DataKeyRanks]), 3)
+}
+
+func TestMaxConcurrentChecks(t *testing.T) {
+	const limit = 2
+
+	c := fake.NewClientBuilder().Build()
+	coord := NewCoordinator(c, CoordinatorConfig{MaxConcurrentChecks: limit})
+	ctx := context.Background()
+	gangInfo := &types.GangInfo{GangID: "limited-gang", ExpectedMinCount: 5}
+	cmName := ConfigMapName(gangInfo.GangID)
+
+	activePeers := func() []string {
+		cm := getConfigMap(t, c, "default", cmName)
+		assert.Equal(t, "2", cm.Data[DataKeyMaxConcurrentChecks])
+
+		return parseNameList(cm.Data[DataKeyActivePeers])
+	}
+
+	pods := []string{"pod-e", "pod-c", "pod-a", "pod-d", "pod-b"}
+	for i, pod := range pods {
+		peer := types.PeerInfo{PodName: pod, PodIP: fmt.Sprintf("10.0.0.%d", i+1)}
+		require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, peer))
+		assert.LessOrEqual(t, len(activePeers()), limit)
+	}
+
+	// Peers that registered first keep their slot even though later peers sort ahead of them.
+	assert.Equal(t, []string{"pod-c", "pod-e"}, activePeers())
+
+	finished := make(map[string]bool)
+
+	for len(finished) < len(pods) {
+		active := activePeers()
+		require.NotEmpty(t, active, "waiting peers must be given a slot once others complete")
+		assert.LessOrEqual(t, len(active), limit)
+
+		for _, pod := range active {
+			assert.False(t, finished[pod], "completed peer %s must not hold a slot", pod)
+		}
+
+		require.NoError(t, coord.CompletePeerChecks(ctx, "default", cmName, active[0]))
+		finished[active[0]] = true
+	}
+
+	assert.Empty(t, activePeers())
+}
+
+func TestPeerStaleTimeout(t *testing.T) {
+	const staleTimeout = 2 * time.Minute
+
+	c := fake.NewClientBuilder().Build()
+	coord := NewCoordinator(c, CoordinatorConfig{PeerStaleTimeout: staleTimeout})
+	ctx := context.Background()
+	gangInfo := &types.GangInfo{GangID: "stale-gang", ExpectedMinCount: 3}
+	cmName := ConfigMapName(gangInfo.GangID)
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	coord.now = func() time.Time { return now }
+
+	peerNames := func() []string {
+		cm := getConfigMap(t, c, "default", cmName)
+
+		var names []string
+		for _, p := range ParsePeers(cm.Data[DataKeyPeers]) {
+			names = append(names, p.PodName)
+		}
+
+		return names
+	}
+
+	// pod-ghost registers and then crashes, never refreshing its heartbeat.
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-ghost", PodIP: "10.0.0.9"}))
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-a", PodIP: "10.0.0.1"}))
+	assert.Equal(t, []string{"pod-a", "pod-ghost"}, peerNames())
+
+	// pod-a keeps heartbeating while pod-ghost ages out.
+	now = now.Add(staleTimeout / 2)
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-a", PodIP: "10.0.0.1"}))
+	assert.Equal(t, []string{"pod-a", "pod-ghost"}, peerNames(), "peer within the timeout must be kept")
+
+	prunedBefore := testutil.ToFloat64(metrics.GangStalePeersPruned)
+
+	now = now.Add(staleTimeout/2 + time.Second)
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-b", PodIP: "10.0.0.2"}))
+	assert.Equal(t, []string{"pod-a", "pod-b"}, peerNames(), "peer with a stale heartbeat must be pruned")
+	assert.Equal(t, prunedBefore+1, testutil.ToFloat64(metrics.GangStalePeersPruned))
+
+	cm := getConfigMap(t, c, "default", cmName)
+	heartbeats := ParseHeartbeats(cm.Data[DataKeyHeartbeats])
+	assert.NotContains(t, heartbeats, "pod-ghost")
+	assert.Equal(t, now, heartbeats["pod-b"])
+	assert.Empty(t, cm.Data[DataKeyRanks], "gang must keep waiting for a live third peer")
+
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-c", PodIP: "10.0.0.3"}))
+	cm = getConfigMap(t, c, "default", cmName)
+	assert.Equal(t, map[string]int{"pod-a": 0, "pod-b": 1, "pod-c": 2}, ParseRanks(cm.Data[DataKeyRanks]))
+	assert.Equal(t, "10.0.0.1", cm.Data[DataKeyMasterAddr])
+
+	// Once ranks are frozen the peer set is final, even if peers stop heartbeating.
+	now = now.Add(10 * staleTimeout)
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-c", PodIP: "10.0.0.3"}))
+	assert.Equal(t, []string{"pod-a", "pod-b", "pod-c"}, peerNames())
+}
+
+func TestPeerStaleTimeoutDisabled(t *testing.T) {
+	coord := newFakeCoordinator()
+	ctx := context.Background()
+	gangInfo := &types.GangInfo{GangID: "no-heartbeat-gang", ExpectedMinCount: 2}
+
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-a", PodIP: "10.0.0.1"}))
+
+	cm := getConfigMap(t, coord.client, "default", ConfigMapName(gangInfo.GangID))
+	assert.NotContains(t, cm.Data, DataKeyHeartbeats)
+}
+
+func TestMaxConcurrentChecksUnlimited(t *testing.T) {
+	coord := newFakeCoordinator()
+	ctx := context.Background()
+	gangInfo := &types.GangInfo{GangID: "unlimited-gang", ExpectedMinCount: 2}
+
+	require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-a", PodIP: "10.0.0.1"}))
+	require.NoError(t, coord.CompletePeerChecks(ctx, "default", ConfigMapName(gangInfo.GangID), "pod-a"))
+
+	cm := getConfigMap(t, coord.client, "default", ConfigMapName(gangInfo.GangID))
+	assert.NotContains(t, cm.Data, DataKeyMaxConcurrentChecks)
+	assert.NotContains(t, cm.Data, DataKeyActivePeers)
+	assert.NotContains(t, cm.Data, DataKeyCompletedPeers)
+}
+
+func TestParseRanks(t *testing.T) {
+	ranks := ParseRanks("pod-a;0\n pod-b ; 1 \nmalformed\npod-c;x\n")
+	assert.Equal(t, map[string]int{"pod-a": 0, "pod-b": 1}, ranks)
+	assert.Empty(t, ParseRanks(""))
+}
+
 // TestUpdateMasterAddr covers master address selection: rank-0 is
-// alphabetically first, empty peer list is a no-op, rank-0 with
-// empty IP doesn't overwrite.
+// alphabetically first unless ranks are frozen, empty peer list is a
+// no-op, rank-0 with empty IP doesn't overwrite.
 func TestUpdateMasterAddr(t *testing.T) {
 	t.Run("rank 0 is alphabetically first", func(t *testing.T) {
 		coord := newFakeCoordinator()
@@ -555,4 +921,14 @@ func TestUpdateMasterAddr(t *testing.T) {
 		coord.updateMasterAddr(cm)
 		assert.Equal(t, "", cm.Data[DataKeyMasterAddr])
 	})
+
+	t.Run("frozen rank 0 is master", func(t *testing.T) {
+		coord := newFakeCoordinator()
+		cm := &corev1.ConfigMap{Data: map[string]string{
+			DataKeyPeers: "pod-a;10.0.0.1;0\npod-z;10.0.0.26;1",
+			DataKeyRanks: "pod-a;1\npod-z;0",
+		}}
+		coord.updateMasterAddr(cm)
+		assert.Equal(t, "10.0.0.26", cm.Data[DataKeyMasterAddr])
+	})
 }
@@ -0,0 +1,127 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nvidia/nvsentinel/preflight/pkg/gang/types"
+	"github.com/nvidia/nvsentinel/preflight/pkg/metrics"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ParseHeartbeats parses the peer heartbeats from a ConfigMap.
+// Format: "podName;RFC3339 timestamp" per line. Malformed lines are skipped.
+func ParseHeartbeats(heartbeatsData string) map[string]time.Time {
+	heartbeats := make(map[string]time.Time)
+
+	for line := range strings.SplitSeq(strings.TrimSpace(heartbeatsData), "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), ";", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		ts, err := time.Parse(time.RFC3339, strings.TrimSpace(parts[1]))
+		if err != nil {
+			continue
+		}
+
+		heartbeats[strings.TrimSpace(parts[0])] = ts
+	}
+
+	return heartbeats
+}
+
+// setHeartbeats writes the heartbeats of the given peers, dropping entries for pods
+// that are no longer peers.
+func setHeartbeats(cm *corev1.ConfigMap, heartbeats map[string]time.Time, peers []types.PeerInfo) {
+	lines := make([]string, 0, len(peers))
+
+	for _, p := range peers {
+		if ts, ok := heartbeats[p.PodName]; ok {
+			lines = append(lines, fmt.Sprintf("%s;%s", p.PodName, ts.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	sort.Strings(lines)
+
+	cm.Data[DataKeyHeartbeats] = strings.Join(lines, "\n")
+}
+
+// recordHeartbeat marks the peer as seen now. Without a stale timeout the heartbeat
+// key is removed.
+func (c *Coordinator) recordHeartbeat(cm *corev1.ConfigMap, peer types.PeerInfo) {
+	if c.config.PeerStaleTimeout <= 0 {
+		delete(cm.Data, DataKeyHeartbeats)
+		return
+	}
+
+	heartbeats := ParseHeartbeats(cm.Data[DataKeyHeartbeats])
+	if peer.PodIP != "" {
+		heartbeats[peer.PodName] = c.now()
+	}
+
+	setHeartbeats(cm, heartbeats, ParsePeers(cm.Data[DataKeyPeers]))
+}
+
+// pruneStalePeers removes peers whose last heartbeat is older than PeerStaleTimeout, so a
+// peer that crashed mid-registration does not hold the gang waiting on a ghost. Peers
+// without a heartbeat (written before pruning was enabled) are adopted with a fresh one.
+// Once ranks are frozen the peer set is final and nothing is pruned. The registering pod
+// is never pruned, since it is about to refresh its own heartbeat.
+func (c *Coordinator) pruneStalePeers(cm *corev1.ConfigMap, registeringPod string) {
+	if c.config.PeerStaleTimeout <= 0 || cm.Data[DataKeyRanks] != "" {
+		return
+	}
+
+	now := c.now()
+	heartbeats := ParseHeartbeats(cm.Data[DataKeyHeartbeats])
+	peers := ParsePeers(cm.Data[DataKeyPeers])
+	live := make([]types.PeerInfo, 0, len(peers))
+
+	for _, p := range peers {
+		lastSeen, ok := heartbeats[p.PodName]
+		if !ok {
+			heartbeats[p.PodName] = now
+			live = append(live, p)
+
+			continue
+		}
+
+		if p.PodName == registeringPod || now.Sub(lastSeen) <= c.config.PeerStaleTimeout {
+			live = append(live, p)
+			continue
+		}
+
+		metrics.GangStalePeersPruned.Inc()
+		slog.Info("Pruning gang peer with stale heartbeat",
+			"configMap", cm.Name,
+			"namespace", cm.Namespace,
+			"stalePod", p.PodName,
+			"lastSeen", lastSeen.Format(time.RFC3339),
+			"staleTimeout", c.config.PeerStaleTimeout)
+	}
+
+	if len(live) != len(peers) {
+		setPeers(cm, live)
+	}
+
+	setHeartbeats(cm, heartbeats, live)
+}
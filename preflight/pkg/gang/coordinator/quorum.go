@@ -0,0 +1,96 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/nvidia/nvsentinel/preflight/pkg/gang/types"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultQuorumPollInterval is how often WaitForQuorum re-reads the gang ConfigMap.
+const DefaultQuorumPollInterval = time.Second
+
+// WaitForQuorum polls the gang ConfigMap until at least minCount peers have registered, timeout
+// passes, or ctx is cancelled. It returns whether the quorum was reached and the pod names of the
+// peers registered at that point, so a caller that timed out can report who showed up. A missing
+// ConfigMap counts as no peers yet, and read errors are retried on the next poll.
+func (c *Coordinator) WaitForQuorum(
+	ctx context.Context,
+	namespace, gangID string,
+	minCount int,
+	timeout time.Duration,
+) (ready bool, present []string, err error) {
+	if minCount <= 0 {
+		return false, nil, fmt.Errorf("quorum size must be positive, got %d", minCount)
+	}
+
+	configMapName := ConfigMapName(gangID)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	ticker := time.NewTicker(c.quorumPollInterval)
+	defer ticker.Stop()
+
+	for {
+		cm := &corev1.ConfigMap{}
+
+		err := c.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapName}, cm)
+
+		switch {
+		case err == nil:
+			present = peerNames(ParsePeers(cm.Data[DataKeyPeers]))
+		case !errors.IsNotFound(err):
+			slog.Debug("Failed to read gang ConfigMap while waiting for quorum",
+				"configMap", configMapName, "namespace", namespace, "error", err)
+		}
+
+		if len(present) >= minCount {
+			return true, present, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timer.C:
+			slog.Warn("Gang quorum not reached before timeout",
+				"configMap", configMapName,
+				"namespace", namespace,
+				"quorum", minCount,
+				"present", len(present),
+				"timeout", timeout)
+
+			return false, present, nil
+		case <-ctx.Done():
+			return false, present, fmt.Errorf("waiting for gang quorum in ConfigMap %s: %w", configMapName, ctx.Err())
+		}
+	}
+}
+
+func peerNames(peers []types.PeerInfo) []string {
+	names := make([]string, 0, len(peers))
+	for _, p := range peers {
+		names = append(names, p.PodName)
+	}
+
+	return names
+}
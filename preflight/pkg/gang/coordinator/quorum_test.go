@@ -0,0 +1,92 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nvidia/nvsentinel/preflight/pkg/gang/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWaitForQuorum(t *testing.T) {
+	newCoordinator := func() *Coordinator {
+		coord := NewCoordinator(fake.NewClientBuilder().Build(), DefaultCoordinatorConfig())
+		coord.quorumPollInterval = 10 * time.Millisecond
+
+		return coord
+	}
+
+	t.Run("returns once peers reach the quorum", func(t *testing.T) {
+		ctx := context.Background()
+		coord := newCoordinator()
+		gangInfo := &types.GangInfo{GangID: "quorum-gang", ExpectedMinCount: 3}
+
+		go func() {
+			for i, pod := range []string{"pod-a", "pod-b"} {
+				time.Sleep(50 * time.Millisecond)
+
+				peer := types.PeerInfo{PodName: pod, PodIP: fmt.Sprintf("10.0.0.%d", i+1)}
+				assert.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, peer))
+			}
+		}()
+
+		ready, present, err := coord.WaitForQuorum(ctx, "default", "quorum-gang", 2, 5*time.Second)
+		require.NoError(t, err)
+		assert.True(t, ready)
+		assert.ElementsMatch(t, []string{"pod-a", "pod-b"}, present)
+	})
+
+	t.Run("times out with the peers that showed up", func(t *testing.T) {
+		ctx := context.Background()
+		coord := newCoordinator()
+		gangInfo := &types.GangInfo{GangID: "slow-gang", ExpectedMinCount: 3}
+		require.NoError(t, coord.RegisterPeer(ctx, "default", gangInfo, types.PeerInfo{PodName: "pod-a", PodIP: "10.0.0.1"}))
+
+		start := time.Now()
+		ready, present, err := coord.WaitForQuorum(ctx, "default", "slow-gang", 3, 200*time.Millisecond)
+		require.NoError(t, err)
+		assert.False(t, ready)
+		assert.Equal(t, []string{"pod-a"}, present)
+		assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+	})
+
+	t.Run("waits for a ConfigMap that does not exist yet", func(t *testing.T) {
+		ready, present, err := newCoordinator().
+			WaitForQuorum(context.Background(), "default", "missing-gang", 1, 100*time.Millisecond)
+		require.NoError(t, err)
+		assert.False(t, ready)
+		assert.Empty(t, present)
+	})
+
+	t.Run("returns an error when ctx is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		ready, _, err := newCoordinator().WaitForQuorum(ctx, "default", "cancelled-gang", 1, time.Minute)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.False(t, ready)
+	})
+
+	t.Run("rejects a non-positive quorum", func(t *testing.T) {
+		_, _, err := newCoordinator().WaitForQuorum(context.Background(), "default", "invalid-gang", 0, time.Second)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DataKeyValuePrefix prefixes the ConfigMap data keys holding values shared by gang members.
+	DataKeyValuePrefix = "value."
+
+	// MaxGangValueSize is the maximum size in bytes of a single shared gang value.
+	MaxGangValueSize = 4 * 1024
+
+	// MaxGangValuesSize is the maximum combined size in bytes of all shared values of a gang,
+	// keeping the coordination ConfigMap well below the 1 MiB object limit.
+	MaxGangValuesSize = 64 * 1024
+)
+
+// PutGangValue stores a small value under key in the gang ConfigMap so that other gang members
+// can read it with GetGangValue. Concurrent writers are retried on conflict, so no write is lost;
+// the last write to the same key wins.
+func (c *Coordinator) PutGangValue(ctx context.Context, namespace, gangID, key, value string) error {
+	dataKey := DataKeyValuePrefix + key
+	if errs := validation.IsConfigMapKey(dataKey); key == "" || len(errs) > 0 {
+		return fmt.Errorf("invalid gang value key %q: %s", key, strings.Join(errs, "; "))
+	}
+
+	if len(value) > MaxGangValueSize {
+		return fmt.Errorf("gang value %q is %d bytes, exceeding the %d byte limit",
+			key, len(value), MaxGangValueSize)
+	}
+
+	configMapName := ConfigMapName(gangID)
+
+	var sizeErr error
+
+	_, err := c.updateConfigMapWithRetry(ctx, namespace, configMapName, func(cm *corev1.ConfigMap) {
+		sizeErr = nil
+
+		total := gangValuesSize(cm) - len(cm.Data[dataKey]) + len(value)
+		if total > MaxGangValuesSize {
+			sizeErr = fmt.Errorf("gang value %q would grow shared values to %d bytes, exceeding the %d byte limit",
+				key, total, MaxGangValuesSize)
+
+			return
+		}
+
+		cm.Data[dataKey] = value
+	})
+	if err != nil {
+		return fmt.Errorf("failed to store gang value %q in ConfigMap %s: %w", key, configMapName, err)
+	}
+
+	return sizeErr
+}
+
+// GetGangValue returns the value stored under key by PutGangValue and whether it was set.
+func (c *Coordinator) GetGangValue(ctx context.Context, namespace, gangID, key string) (string, bool, error) {
+	configMapName := ConfigMapName(gangID)
+
+	cm := &corev1.ConfigMap{}
+	if err := c.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapName}, cm); err != nil {
+		return "", false, fmt.Errorf("failed to get ConfigMap %s: %w", configMapName, err)
+	}
+
+	value, ok := cm.Data[DataKeyValuePrefix+key]
+
+	return value, ok, nil
+}
+
+// gangValuesSize returns the combined size of the shared values stored in the ConfigMap.
+func gangValuesSize(cm *corev1.ConfigMap) int {
+	size := 0
+
+	for k, v := range cm.Data {
+		if strings.HasPrefix(k, DataKeyValuePrefix) {
+			size += len(v)
+		}
+	}
+
+	return size
+}
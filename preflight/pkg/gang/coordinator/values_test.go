@@ -0,0 +1,143 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGangValues(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("round trips values", func(t *testing.T) {
+		coord := newFakeCoordinator()
+		require.NoError(t, coord.EnsureConfigMap(ctx, "default", "value-gang", 2))
+
+		require.NoError(t, coord.PutGangValue(ctx, "default", "value-gang", "seed", "12345"))
+
+		value, ok, err := coord.GetGangValue(ctx, "default", "value-gang", "seed")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, "12345", value)
+
+		require.NoError(t, coord.PutGangValue(ctx, "default", "value-gang", "seed", "67890"))
+
+		value, _, err = coord.GetGangValue(ctx, "default", "value-gang", "seed")
+		require.NoError(t, err)
+		assert.Equal(t, "67890", value)
+
+		_, ok, err = coord.GetGangValue(ctx, "default", "value-gang", "missing")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("does not touch coordination keys", func(t *testing.T) {
+		coord := newFakeCoordinator()
+		require.NoError(t, coord.EnsureConfigMap(ctx, "default", "value-gang", 2))
+
+		require.NoError(t, coord.PutGangValue(ctx, "default", "value-gang", "master_addr", "10.0.0.9"))
+
+		cm := getConfigMap(t, coord.client, "default", ConfigMapName("value-gang"))
+		assert.Empty(t, cm.Data[DataKeyMasterAddr])
+		assert.Equal(t, "10.0.0.9", cm.Data[DataKeyValuePrefix+"master_addr"])
+	})
+
+	t.Run("rejects invalid keys", func(t *testing.T) {
+		coord := newFakeCoordinator()
+		require.NoError(t, coord.EnsureConfigMap(ctx, "default", "value-gang", 2))
+
+		for _, key := range []string{"", "has space", "slash/key"} {
+			assert.Error(t, coord.PutGangValue(ctx, "default", "value-gang", key, "v"), "key %q", key)
+		}
+	})
+
+	t.Run("enforces size limits", func(t *testing.T) {
+		coord := newFakeCoordinator()
+		require.NoError(t, coord.EnsureConfigMap(ctx, "default", "value-gang", 2))
+
+		err := coord.PutGangValue(ctx, "default", "value-gang", "big", strings.Repeat("x", MaxGangValueSize+1))
+		assert.ErrorContains(t, err, "byte limit")
+
+		value := strings.Repeat("x", MaxGangValueSize)
+		for i := range MaxGangValuesSize / MaxGangValueSize {
+			require.NoError(t, coord.PutGangValue(ctx, "default", "value-gang", fmt.Sprintf("v%d", i), value))
+		}
+
+		err = coord.PutGangValue(ctx, "default", "value-gang", "one-more", "x")
+		assert.ErrorContains(t, err, "byte limit")
+
+		_, ok, err := coord.GetGangValue(ctx, "default", "value-gang", "one-more")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		// Replacing an existing value only counts the difference in size.
+		require.NoError(t, coord.PutGangValue(ctx, "default", "value-gang", "v0", "small"))
+	})
+
+	t.Run("missing ConfigMap", func(t *testing.T) {
+		coord := newFakeCoordinator()
+
+		assert.Error(t, coord.PutGangValue(ctx, "default", "no-gang", "seed", "1"))
+
+		_, _, err := coord.GetGangValue(ctx, "default", "no-gang", "seed")
+		assert.Error(t, err)
+	})
+}
+
+// TestPutGangValueConcurrent covers gang members writing different keys at the same
+// time: every write must survive the resulting update conflicts.
+func TestPutGangValueConcurrent(t *testing.T) {
+	const writers = 16
+
+	coord := newFakeCoordinator()
+	ctx := context.Background()
+
+	require.NoError(t, coord.EnsureConfigMap(ctx, "default", "concurrent-values", writers))
+
+	var wg sync.WaitGroup
+
+	errs := make(chan error, writers)
+
+	for i := range writers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			errs <- coord.PutGangValue(ctx, "default", "concurrent-values", fmt.Sprintf("key-%02d", i), fmt.Sprint(i))
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	for i := range writers {
+		value, ok, err := coord.GetGangValue(ctx, "default", "concurrent-values", fmt.Sprintf("key-%02d", i))
+		require.NoError(t, err)
+		assert.True(t, ok, "no write should be lost")
+		assert.Equal(t, fmt.Sprint(i), value)
+	}
+}
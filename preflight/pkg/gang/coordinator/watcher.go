@@ -0,0 +1,113 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// GangWatcher watches a single gang ConfigMap through a namespaced informer and
+// signals when the gang reaches its expected size, instead of polling the ConfigMap.
+type GangWatcher struct {
+	clientset     kubernetes.Interface
+	namespace     string
+	configMapName string
+}
+
+func NewGangWatcher(clientset kubernetes.Interface, namespace, gangID string) *GangWatcher {
+	return &GangWatcher{
+		clientset:     clientset,
+		namespace:     namespace,
+		configMapName: ConfigMapName(gangID),
+	}
+}
+
+// WaitForGangReady starts watching the gang ConfigMap and returns, after the initial
+// sync, a channel that is closed once the ConfigMap lists at least the expected number of peers.
+// The watch stops when the gang is ready or ctx is cancelled, whichever comes first;
+// in the latter case the channel is never closed.
+func (w *GangWatcher) WaitForGangReady(ctx context.Context) (<-chan struct{}, error) {
+	ready := make(chan struct{})
+
+	factory := informers.NewSharedInformerFactoryWithOptions(w.clientset, 0,
+		informers.WithNamespace(w.namespace),
+		informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+			opts.FieldSelector = fields.OneTermEqualSelector("metadata.name", w.configMapName).String()
+		}),
+	)
+	informer := factory.Core().V1().ConfigMaps().Informer()
+
+	var once sync.Once
+
+	check := func(obj any) {
+		cm, ok := obj.(*corev1.ConfigMap)
+		if !ok || cm.Name != w.configMapName || !isGangReady(cm) {
+			return
+		}
+
+		once.Do(func() {
+			slog.Info("Gang is ready",
+				"configMap", w.configMapName,
+				"namespace", w.namespace,
+				"expectedCount", cm.Data[DataKeyExpectedCount])
+			close(ready)
+		})
+	}
+
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    check,
+		UpdateFunc: func(_, newObj any) { check(newObj) },
+	}); err != nil {
+		return nil, fmt.Errorf("failed to add event handler for ConfigMap %s: %w", w.configMapName, err)
+	}
+
+	stopCh := make(chan struct{})
+
+	go func() {
+		defer close(stopCh)
+
+		select {
+		case <-ready:
+		case <-ctx.Done():
+		}
+	}()
+
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+
+	return ready, nil
+}
+
+// isGangReady reports whether the ConfigMap lists at least the expected number of peers.
+// A skeleton ConfigMap with an unknown (zero) expected count is never ready.
+func isGangReady(cm *corev1.ConfigMap) bool {
+	expectedCount, _ := strconv.Atoi(cm.Data[DataKeyExpectedCount])
+	if expectedCount <= 0 {
+		return false
+	}
+
+	return len(ParsePeers(cm.Data[DataKeyPeers])) >= expectedCount
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestWaitForGangReady(t *testing.T) {
+	t.Run("wakes when peers reach expected count", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName("watch-gang"), Namespace: "default"},
+			Data: map[string]string{
+				DataKeyExpectedCount: "2",
+				DataKeyPeers:         "",
+			},
+		}
+		clientset := k8sfake.NewSimpleClientset(cm)
+
+		ready, err := NewGangWatcher(clientset, "default", "watch-gang").WaitForGangReady(ctx)
+		require.NoError(t, err)
+
+		cm.Data[DataKeyPeers] = "pod-a;10.0.0.1;0;"
+		_, err = clientset.CoreV1().ConfigMaps("default").Update(ctx, cm, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		assert.Never(t, func() bool {
+			select {
+			case <-ready:
+				return true
+			default:
+				return false
+			}
+		}, 200*time.Millisecond, 20*time.Millisecond, "gang should not be ready with one of two peers")
+
+		cm.Data[DataKeyPeers] = "pod-a;10.0.0.1;0;\npod-b;10.0.0.2;1;"
+		_, err = clientset.CoreV1().ConfigMaps("default").Update(ctx, cm, metav1.UpdateOptions{})
+		require.NoError(t, err)
+
+		select {
+		case <-ready:
+		case <-time.After(2 * time.Second):
+			t.Fatal("waiter was not woken after the gang reached its expected size")
+		}
+	})
+
+	t.Run("ready immediately when ConfigMap is already complete", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName("done-gang"), Namespace: "default"},
+			Data: map[string]string{
+				DataKeyExpectedCount: "1",
+				DataKeyPeers:         "pod-a;10.0.0.1;0;",
+			},
+		}
+
+		ready, err := NewGangWatcher(k8sfake.NewSimpleClientset(cm), "default", "done-gang").WaitForGangReady(ctx)
+		require.NoError(t, err)
+
+		select {
+		case <-ready:
+		case <-time.After(2 * time.Second):
+			t.Fatal("waiter was not woken for an already complete gang")
+		}
+	})
+}
+
+func TestIsGangReady(t *testing.T) {
+	tests := []struct {
+		name string
+		data map[string]string
+		want bool
+	}{
+		{name: "skeleton", data: map[string]string{DataKeyExpectedCount: "0", DataKeyPeers: "pod-a;10.0.0.1;0;"}, want: false},
+		{name: "partial", data: map[string]string{DataKeyExpectedCount: "2", DataKeyPeers: "pod-a;10.0.0.1;0;"}, want: false},
+		{name: "complete", data: map[string]string{DataKeyExpectedCount: "2", DataKeyPeers: "pod-a;10.0.0.1;0;\npod-b;10.0.0.2;1;"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isGangReady(&corev1.ConfigMap{Data: tt.data}))
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discoverer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/nvidia/nvsentinel/preflight/pkg/gang/types"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// JobSetNameLabel is the label the JobSet controller sets on every pod of a JobSet.
+const JobSetNameLabel = "jobset.sigs.k8s.io/jobset-name"
+
+// JobSetGVK is the GroupVersionKind for JobSet resources.
+var JobSetGVK = schema.GroupVersionKind{
+	Group:   "jobset.x-k8s.io",
+	Version: "v1alpha2",
+	Kind:    "JobSet",
+}
+
+// JobSetDiscoverer discovers gang members of a JobSet. All pods carrying the same
+// jobset.sigs.k8s.io/jobset-name label form one gang, and the expected size is the
+// sum of replicas x parallelism across the JobSet's replicatedJobs.
+type JobSetDiscoverer struct {
+	client          client.Reader
+	podPhases       podPhaseSet
+	terminatingPods TerminatingPodPolicy
+}
+
+// NewJobSetDiscoverer creates a new JobSet gang discoverer.
+// podPhases are the pod phases accepted as gang peers; empty means DefaultPodPhases.
+// terminatingPods controls whether terminating pods are peers; empty means TerminatingPodsExclude.
+func NewJobSetDiscoverer(
+	c client.Reader,
+	podPhases []corev1.PodPhase,
+	terminatingPods TerminatingPodPolicy,
+) *JobSetDiscoverer {
+	return &JobSetDiscoverer{
+		client:          c,
+		podPhases:       newPodPhaseSet(podPhases),
+		terminatingPods: terminatingPods,
+	}
+}
+
+func (j *JobSetDiscoverer) Name() string {
+	return "jobset"
+}
+
+// CanHandle returns true if the pod belongs to a JobSet.
+func (j *JobSetDiscoverer) CanHandle(pod *corev1.Pod) bool {
+	return getJobSetName(pod) != ""
+}
+
+// ExtractGangID extracts the gang identifier from a pod's JobSet label.
+func (j *JobSetDiscoverer) ExtractGangID(pod *corev1.Pod) string {
+	jobSetName := getJobSetName(pod)
+	if jobSetName == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("jobset-%s-%s", pod.Namespace, jobSetName)
+}
+
+// DiscoverPeers finds all pods of the same JobSet.
+func (j *JobSetDiscoverer) DiscoverPeers(ctx context.Context, pod *corev1.Pod) (*types.GangInfo, error) {
+	if !j.CanHandle(pod) {
+		return nil, nil
+	}
+
+	jobSetName := getJobSetName(pod)
+	gangID := j.ExtractGangID(pod)
+
+	slog.Info("Discovering JobSet gang",
+		"pod", pod.Name,
+		"namespace", pod.Namespace,
+		"jobSet", jobSetName,
+		"gangID", gangID)
+
+	peers, err := findLabelledPeers(ctx, j.client, pod.Namespace, JobSetNameLabel, jobSetName,
+		j.podPhases, j.terminatingPods)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(peers) == 0 {
+		slog.Warn("No peers found for JobSet gang",
+			"pod", pod.Name,
+			"jobSet", jobSetName,
+			"gangID", gangID)
+
+		return nil, nil
+	}
+
+	expectedMinCount, err := j.getJobSetSize(ctx, pod.Namespace, jobSetName)
+	if err != nil {
+		slog.Warn("Failed to get JobSet size, will use discovered pod count",
+			"jobSet", jobSetName,
+			"namespace", pod.Namespace,
+			"error", err)
+	}
+
+	if expectedMinCount == 0 {
+		expectedMinCount = len(peers)
+	}
+
+	slog.Info("Discovered JobSet gang",
+		"gangID", gangID,
+		"jobSet", jobSetName,
+		"expectedCount", expectedMinCount,
+		"discoveredPeers", len(peers))
+
+	return &types.GangInfo{
+		GangID:           gangID,
+		ExpectedMinCount: expectedMinCount,
+		Peers:            peers,
+	}, nil
+}
+
+// getJobSetSize sums replicas x parallelism across a JobSet's replicatedJobs. Both
+// fields default to 1 when unset, matching the JobSet and Job API defaults.
+func (j *JobSetDiscoverer) getJobSetSize(ctx context.Context, namespace, name string) (int, error) {
+	jobSet := &unstructured.Unstructured{}
+	jobSet.SetGroupVersionKind(JobSetGVK)
+
+	if err := j.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, jobSet); err != nil {
+		return 0, fmt.Errorf("failed to get JobSet %s/%s: %w", namespace, name, err)
+	}
+
+	replicatedJobs, _, err := unstructured.NestedSlice(jobSet.Object, "spec", "replicatedJobs")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get replicatedJobs from JobSet %s/%s: %w", namespace, name, err)
+	}
+
+	total := 0
+
+	for _, rjRaw := range replicatedJobs {
+		rj, ok := rjRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		replicas, found, _ := unstructured.NestedInt64(rj, "replicas")
+		if !found {
+			replicas = 1
+		}
+
+		parallelism, found, _ := unstructured.NestedInt64(rj, "template", "spec", "parallelism")
+		if !found {
+			parallelism = 1
+		}
+
+		total += int(replicas * parallelism)
+	}
+
+	return total, nil
+}
+
+// getJobSetName extracts the JobSet name label from a pod.
+// Returns empty string if not present.
+func getJobSetName(pod *corev1.Pod) string {
+	return pod.Labels[JobSetNameLabel]
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discoverer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestJobSetDiscoverer_CanHandle(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want bool
+	}{
+		{
+			name: "matches jobset label",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{JobSetNameLabel: "train"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "no jobset label",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"some-label": "value"},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "no labels",
+			pod:  &corev1.Pod{},
+			want: false,
+		},
+	}
+
+	d := NewJobSetDiscoverer(nil, nil, "")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.CanHandle(tt.pod); got != tt.want {
+				t.Errorf("CanHandle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJobSetDiscoverer_ExtractGangID(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want string
+	}{
+		{
+			name: "gang ID format",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Labels:    map[string]string{JobSetNameLabel: "train"},
+				},
+			},
+			want: "jobset-default-train",
+		},
+		{
+			name: "no jobset label returns empty",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+			},
+			want: "",
+		},
+	}
+
+	d := NewJobSetDiscoverer(nil, nil, "")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.ExtractGangID(tt.pod); got != tt.want {
+				t.Errorf("ExtractGangID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// --- DiscoverPeers tests ---
+
+// makeJobSet builds a JobSet whose replicatedJobs have the given replicas and parallelism.
+// A zero value leaves the field unset.
+func makeJobSet(namespace, name string, jobs ...[2]int64) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(JobSetGVK)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	replicatedJobs := make([]any, 0, len(jobs))
+
+	for _, job := range jobs {
+		rj := map[string]any{"name": "workers"}
+		if job[0] != 0 {
+			rj["replicas"] = job[0]
+		}
+
+		if job[1] != 0 {
+			rj["template"] = map[string]any{"spec": map[string]any{"parallelism": job[1]}}
+		}
+
+		replicatedJobs = append(replicatedJobs, rj)
+	}
+
+	_ = unstructured.SetNestedSlice(obj.Object, replicatedJobs, "spec", "replicatedJobs")
+
+	return obj
+}
+
+func makeJobSetPod(name, namespace, jobSetName, ip string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{JobSetNameLabel: jobSetName},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   "node-1",
+			Containers: []corev1.Container{{Name: "c", Image: "img"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			PodIP: ip,
+		},
+	}
+}
+
+func TestJobSetDiscoverer_DiscoverPeers(t *testing.T) {
+	t.Run("sums replicas times parallelism across replicatedJobs", func(t *testing.T) {
+		js := makeJobSet("default", "train", [2]int64{2, 2}, [2]int64{1, 0})
+		objs := []runtime.Object{
+			js,
+			makeJobSetPod("train-0", "default", "train", "10.0.0.1", corev1.PodRunning),
+			makeJobSetPod("train-1", "default", "train", "10.0.0.2", corev1.PodRunning),
+			makeJobSetPod("train-2", "default", "train", "10.0.0.3", corev1.PodPending),
+			makeJobSetPod("other-0", "default", "other", "10.0.0.4", corev1.PodRunning),
+		}
+
+		c := fake.NewClientBuilder().WithRuntimeObjects(objs...).Build()
+		d := NewJobSetDiscoverer(c, nil, "")
+
+		info, err := d.DiscoverPeers(context.Background(),
+			makeJobSetPod("train-0", "default", "train", "10.0.0.1", corev1.PodRunning))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, "jobset-default-train", info.GangID)
+		assert.Len(t, info.Peers, 3)
+		assert.Equal(t, 5, info.ExpectedMinCount, "partially scheduled gang must not look complete")
+	})
+
+	t.Run("missing JobSet falls back to discovered peer count", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithRuntimeObjects(
+			makeJobSetPod("train-0", "default", "train", "10.0.0.1", corev1.PodRunning),
+			makeJobSetPod("train-1", "default", "train", "10.0.0.2", corev1.PodRunning),
+		).Build()
+		d := NewJobSetDiscoverer(c, nil, "")
+
+		info, err := d.DiscoverPeers(context.Background(),
+			makeJobSetPod("train-0", "default", "train", "10.0.0.1", corev1.PodRunning))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, 2, info.ExpectedMinCount)
+	})
+
+	t.Run("pod without jobset label returns nil", func(t *testing.T) {
+		d := NewJobSetDiscoverer(fake.NewClientBuilder().Build(), nil, "")
+
+		info, err := d.DiscoverPeers(context.Background(), &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: "default"},
+		})
+		require.NoError(t, err)
+		assert.Nil(t, info)
+	})
+}
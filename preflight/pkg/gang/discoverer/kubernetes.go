@@ -21,8 +21,11 @@ import (
 	"log/slog"
 
 	"github.com/nvidia/nvsentinel/preflight/pkg/gang/types"
+	"github.com/nvidia/nvsentinel/preflight/pkg/metrics"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -35,6 +38,9 @@ var WorkloadGVK = schema.GroupVersionKind{
 	Kind:    "Workload",
 }
 
+// WorkloadRefIndexField is the pod field index holding the name of the Workload a pod references.
+const WorkloadRefIndexField = "spec.workloadRef.name"
+
 // WorkloadRefDiscoverer discovers gang members using K8s 1.35+ native workloadRef.
 // Pods are linked to Workloads via spec.workloadRef:
 //
@@ -43,13 +49,28 @@ var WorkloadGVK = schema.GroupVersionKind{
 //	    name: training-job-workload
 //	    podGroup: workers
 type WorkloadRefDiscoverer struct {
-	client client.Client
+	client          client.Reader
+	podCache        client.Reader
+	podPhases       podPhaseSet
+	terminatingPods TerminatingPodPolicy
 }
 
 // NewWorkloadRefDiscoverer creates a new workloadRef gang discoverer.
-func NewWorkloadRefDiscoverer(c client.Client) *WorkloadRefDiscoverer {
+// podCache is an optional informer cache with the WorkloadRefIndexField pod index (see
+// IndexPodsByWorkloadRef); when set, peers are read from the index instead of listing the namespace.
+// podPhases are the pod phases accepted as gang peers; empty means DefaultPodPhases.
+// terminatingPods controls whether terminating pods are peers; empty means TerminatingPodsExclude.
+func NewWorkloadRefDiscoverer(
+	c client.Reader,
+	podCache client.Reader,
+	podPhases []corev1.PodPhase,
+	terminatingPods TerminatingPodPolicy,
+) *WorkloadRefDiscoverer {
 	return &WorkloadRefDiscoverer{
-		client: c,
+		client:          c,
+		podCache:        podCache,
+		podPhases:       newPodPhaseSet(podPhases),
+		terminatingPods: terminatingPods,
 	}
 }
 
@@ -130,12 +151,30 @@ func (w *WorkloadRefDiscoverer) DiscoverPeers(
 }
 
 // fetchExpectedMinCount retrieves expected count, logging any errors.
+// A missing Workload (or Workload API) is a benign fallback to the discovered
+// pod count; a Forbidden error points at misconfigured RBAC and is surfaced loudly.
 func (w *WorkloadRefDiscoverer) fetchExpectedMinCount(
 	ctx context.Context,
 	namespace, workloadName, podGroup string,
 ) int {
 	count, err := w.getWorkloadMinCount(ctx, namespace, workloadName, podGroup)
-	if err != nil {
+
+	switch {
+	case err == nil:
+	case apierrors.IsForbidden(err):
+		metrics.GangWorkloadLookupErrors.WithLabelValues(metrics.WorkloadLookupReasonForbidden).Inc()
+		slog.Error("Access to Workload forbidden, check preflight RBAC for scheduling.k8s.io workloads; "+
+			"will use discovered pod count",
+			"workload", workloadName,
+			"namespace", namespace,
+			"error", err)
+	case apierrors.IsNotFound(err) || meta.IsNoMatchError(err):
+		metrics.GangWorkloadLookupErrors.WithLabelValues(metrics.WorkloadLookupReasonNotFound).Inc()
+		slog.Info("Workload not found, will use discovered pod count",
+			"workload", workloadName,
+			"namespace", namespace)
+	default:
+		metrics.GangWorkloadLookupErrors.WithLabelValues(metrics.WorkloadLookupReasonOther).Inc()
 		slog.Warn("Failed to get Workload minCount, will use discovered pod count",
 			"workload", workloadName,
 			"error", err)
@@ -144,23 +183,17 @@ func (w *WorkloadRefDiscoverer) fetchExpectedMinCount(
 	return count
 }
 
-// findPeers lists pods matching the workloadRef.
+// findPeers lists pods matching the workloadRef, from the pod index when a cache is configured
+// and otherwise from the whole namespace.
 func (w *WorkloadRefDiscoverer) findPeers(
 	ctx context.Context,
 	namespace, workloadName, podGroup string,
 ) ([]types.PeerInfo, error) {
-	var podList corev1.PodList
-	if err := w.client.List(ctx, &podList, client.InNamespace(namespace)); err != nil {
-		return nil, fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
-	}
-
 	var peers []types.PeerInfo
 
-	for i := range podList.Items {
-		p := &podList.Items[i]
-
+	visit := func(p *corev1.Pod) {
 		if !w.isPeerMatch(p, workloadName, podGroup) {
-			continue
+			return
 		}
 
 		peers = append(peers, types.PeerInfo{
@@ -171,9 +204,50 @@ func (w *WorkloadRefDiscoverer) findPeers(
 		})
 	}
 
+	if w.podCache == nil {
+		if err := forEachPod(ctx, w.client, namespace, visit); err != nil {
+			return nil, err
+		}
+
+		return peers, nil
+	}
+
+	var podList corev1.PodList
+	if err := w.podCache.List(ctx, &podList,
+		client.InNamespace(namespace), client.MatchingFields{WorkloadRefIndexField: workloadName}); err != nil {
+		return nil, fmt.Errorf("failed to list pods of workload %s/%s from cache: %w", namespace, workloadName, err)
+	}
+
+	for i := range podList.Items {
+		visit(&podList.Items[i])
+	}
+
 	return peers, nil
 }
 
+// IndexPodsByWorkloadRef registers the WorkloadRefIndexField pod index on indexer.
+func IndexPodsByWorkloadRef(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &corev1.Pod{}, WorkloadRefIndexField, podWorkloadRefIndexer); err != nil {
+		return fmt.Errorf("failed to add indexer on Pods for %s: %w", WorkloadRefIndexField, err)
+	}
+
+	return nil
+}
+
+func podWorkloadRefIndexer(obj client.Object) []string {
+	p, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil
+	}
+
+	name := getWorkloadRefName(p)
+	if name == "" {
+		return nil
+	}
+
+	return []string{name}
+}
+
 // isPeerMatch checks if a pod matches the workloadRef criteria.
 func (w *WorkloadRefDiscoverer) isPeerMatch(p *corev1.Pod, workloadName, podGroup string) bool {
 	pWorkloadName := getWorkloadRefName(p)
@@ -185,7 +259,7 @@ func (w *WorkloadRefDiscoverer) isPeerMatch(p *corev1.Pod, workloadName, podGrou
 		return false
 	}
 
-	return p.Status.Phase == corev1.PodRunning || p.Status.Phase == corev1.PodPending
+	return isActivePeer(p, w.podPhases, w.terminatingPods)
 }
 
 // getWorkloadMinCount retrieves the minCount from a Workload's podGroup gang policy.
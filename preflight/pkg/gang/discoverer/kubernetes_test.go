@@ -17,14 +17,21 @@ package discoverer
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/nvidia/nvsentinel/preflight/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func makePodWithWorkloadRef(ns, workload, podGroup string) *corev1.Pod {
@@ -43,7 +50,7 @@ func makePodWithWorkloadRef(ns, workload, podGroup string) *corev1.Pod {
 }
 
 func TestWorkloadRefDiscoverer_CanHandle(t *testing.T) {
-	d := NewWorkloadRefDiscoverer(nil)
+	d := NewWorkloadRefDiscoverer(nil, nil, nil, "")
 
 	tests := []struct {
 		name     string
@@ -66,7 +73,7 @@ func TestWorkloadRefDiscoverer_CanHandle(t *testing.T) {
 }
 
 func TestWorkloadRefDiscoverer_ExtractGangID(t *testing.T) {
-	d := NewWorkloadRefDiscoverer(nil)
+	d := NewWorkloadRefDiscoverer(nil, nil, nil, "")
 
 	tests := []struct {
 		name     string
@@ -92,7 +99,7 @@ func TestWorkloadRefDiscoverer_ExtractGangID(t *testing.T) {
 }
 
 func TestWorkloadRefDiscoverer_Name(t *testing.T) {
-	d := NewWorkloadRefDiscoverer(nil)
+	d := NewWorkloadRefDiscoverer(nil, nil, nil, "")
 
 	if got := d.Name(); got != "kubernetes" {
 		t.Errorf("Name() = %q, want %q", got, "kubernetes")
@@ -139,6 +146,65 @@ func makeWorkloadPod(name, namespace, workload, podGroup, ip string, phase corev
 	return pod
 }
 
+func TestWorkloadRefDiscoverer_PodIndexMatchesLiveList(t *testing.T) {
+	objs := []runtime.Object{
+		makeWorkloadCRD("default", "train", []map[string]any{
+			{"name": "workers", "policy": map[string]any{"gang": map[string]any{"minCount": int64(3)}}},
+		}),
+		makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning),
+		makeWorkloadPod("w-1", "default", "train", "workers", "10.0.0.2", corev1.PodRunning),
+		makeWorkloadPod("w-2", "default", "train", "workers", "10.0.0.3", corev1.PodPending),
+		makeWorkloadPod("w-3", "default", "train", "workers", "10.0.0.4", corev1.PodFailed),
+		makeWorkloadPod("l-0", "default", "train", "launcher", "10.0.0.5", corev1.PodRunning),
+		makeWorkloadPod("e-0", "default", "eval", "workers", "10.0.0.6", corev1.PodRunning),
+		makeWorkloadPod("w-0", "team-b", "train", "workers", "10.0.1.1", corev1.PodRunning),
+		makeWorkloadPod("plain", "default", "", "", "10.0.0.7", corev1.PodRunning),
+	}
+
+	live := NewWorkloadRefDiscoverer(fake.NewClientBuilder().WithRuntimeObjects(objs...).Build(), nil, nil, "")
+
+	podCache := fake.NewClientBuilder().
+		WithRuntimeObjects(objs...).
+		WithIndex(&corev1.Pod{}, WorkloadRefIndexField, podWorkloadRefIndexer).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, cl client.WithWatch, list client.ObjectList,
+				opts ...client.ListOption) error {
+				listOpts := &client.ListOptions{}
+				listOpts.ApplyOptions(opts)
+
+				if listOpts.FieldSelector == nil {
+					t.Errorf("pod cache listed without the %s index", WorkloadRefIndexField)
+				}
+
+				return cl.List(ctx, list, opts...)
+			},
+		}).
+		Build()
+	indexed := NewWorkloadRefDiscoverer(fake.NewClientBuilder().WithRuntimeObjects(objs...).Build(), podCache, nil, "")
+
+	for _, pod := range []*corev1.Pod{
+		makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning),
+		makeWorkloadPod("l-0", "default", "train", "launcher", "10.0.0.5", corev1.PodRunning),
+		makeWorkloadPod("w-0", "default", "train", "", "10.0.0.1", corev1.PodRunning),
+		makeWorkloadPod("e-0", "default", "eval", "workers", "10.0.0.6", corev1.PodRunning),
+		makeWorkloadPod("w-0", "team-b", "train", "workers", "10.0.1.1", corev1.PodRunning),
+	} {
+		name := pod.Namespace + "/" + getWorkloadRefName(pod) + "/" + getWorkloadRefPodGroup(pod)
+
+		want, err := live.DiscoverPeers(context.Background(), pod)
+		require.NoError(t, err, name)
+		require.NotNil(t, want, name)
+
+		got, err := indexed.DiscoverPeers(context.Background(), pod)
+		require.NoError(t, err, name)
+		require.NotNil(t, got, name)
+
+		assert.Equal(t, want.GangID, got.GangID, name)
+		assert.Equal(t, want.ExpectedMinCount, got.ExpectedMinCount, name)
+		assert.ElementsMatch(t, want.Peers, got.Peers, name)
+	}
+}
+
 func TestWorkloadRefDiscoverer_DiscoverPeers(t *testing.T) {
 	t.Run("discovers peers by workloadRef", func(t *testing.T) {
 		workload := makeWorkloadCRD("default", "train", []map[string]any{
@@ -152,7 +218,7 @@ func TestWorkloadRefDiscoverer_DiscoverPeers(t *testing.T) {
 		}
 
 		c := fake.NewClientBuilder().WithRuntimeObjects(append(pods, workload)...).Build()
-		d := NewWorkloadRefDiscoverer(c)
+		d := NewWorkloadRefDiscoverer(c, nil, nil, "")
 
 		info, err := d.DiscoverPeers(context.Background(), makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning))
 		require.NoError(t, err)
@@ -162,10 +228,83 @@ func TestWorkloadRefDiscoverer_DiscoverPeers(t *testing.T) {
 		assert.Equal(t, "kubernetes-default-train-workers", info.GangID)
 	})
 
+	t.Run("configured phases include Succeeded", func(t *testing.T) {
+		pods := []runtime.Object{
+			makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning),
+			makeWorkloadPod("w-1", "default", "train", "workers", "10.0.0.2", corev1.PodSucceeded),
+			makeWorkloadPod("w-2", "default", "train", "workers", "10.0.0.3", corev1.PodFailed),
+		}
+
+		c := fake.NewClientBuilder().WithRuntimeObjects(pods...).Build()
+		d := NewWorkloadRefDiscoverer(c, nil, []corev1.PodPhase{corev1.PodRunning, corev1.PodSucceeded}, "")
+
+		info, err := d.DiscoverPeers(context.Background(), makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Len(t, info.Peers, 2, "Running and Succeeded should be included")
+	})
+
+	t.Run("configured phases exclude Pending", func(t *testing.T) {
+		pods := []runtime.Object{
+			makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning),
+			makeWorkloadPod("w-1", "default", "train", "workers", "10.0.0.2", corev1.PodPending),
+		}
+
+		c := fake.NewClientBuilder().WithRuntimeObjects(pods...).Build()
+		d := NewWorkloadRefDiscoverer(c, nil, []corev1.PodPhase{corev1.PodRunning}, "")
+
+		info, err := d.DiscoverPeers(context.Background(), makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		require.Len(t, info.Peers, 1, "Pending should be excluded")
+		assert.Equal(t, "w-0", info.Peers[0].PodName)
+	})
+
+	t.Run("terminating peer included by policy", func(t *testing.T) {
+		terminating := makeWorkloadPod("w-1", "default", "train", "workers", "10.0.0.2", corev1.PodRunning)
+		terminating.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		terminating.Finalizers = []string{"test.io/hold"}
+		pods := []runtime.Object{
+			makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning),
+			terminating,
+		}
+
+		c := fake.NewClientBuilder().WithRuntimeObjects(pods...).Build()
+
+		d := NewWorkloadRefDiscoverer(c, nil, nil, TerminatingPodsInclude)
+
+		info, err := d.DiscoverPeers(context.Background(), makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Len(t, info.Peers, 2, "terminating pod should be included")
+	})
+
+	t.Run("terminating peer excluded", func(t *testing.T) {
+		terminating := makeWorkloadPod("w-1", "default", "train", "workers", "10.0.0.2", corev1.PodRunning)
+		terminating.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		terminating.Finalizers = []string{"test.io/hold"}
+		pods := []runtime.Object{
+			makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning),
+			terminating,
+		}
+
+		c := fake.NewClientBuilder().WithRuntimeObjects(pods...).Build()
+
+		for _, policy := range []TerminatingPodPolicy{"", TerminatingPodsExclude} {
+			d := NewWorkloadRefDiscoverer(c, nil, nil, policy)
+
+			info, err := d.DiscoverPeers(context.Background(), makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning))
+			require.NoError(t, err)
+			require.NotNil(t, info)
+			require.Len(t, info.Peers, 1, "terminating pod should be excluded with policy %q", policy)
+			assert.Equal(t, "w-0", info.Peers[0].PodName)
+		}
+	})
+
 	t.Run("no matching pods returns nil", func(t *testing.T) {
 		workload := makeWorkloadCRD("default", "train", nil)
 		c := fake.NewClientBuilder().WithRuntimeObjects(workload).Build()
-		d := NewWorkloadRefDiscoverer(c)
+		d := NewWorkloadRefDiscoverer(c, nil, nil, "")
 
 		info, err := d.DiscoverPeers(context.Background(), makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning))
 		require.NoError(t, err)
@@ -178,7 +317,7 @@ func TestWorkloadRefDiscoverer_DiscoverPeers(t *testing.T) {
 			makeWorkloadPod("w-1", "default", "missing", "workers", "10.0.0.2", corev1.PodRunning),
 		}
 		c := fake.NewClientBuilder().WithRuntimeObjects(pods...).Build()
-		d := NewWorkloadRefDiscoverer(c)
+		d := NewWorkloadRefDiscoverer(c, nil, nil, "")
 
 		info, err := d.DiscoverPeers(context.Background(), makeWorkloadPod("w-0", "default", "missing", "workers", "10.0.0.1", corev1.PodRunning))
 		require.NoError(t, err)
@@ -189,7 +328,7 @@ func TestWorkloadRefDiscoverer_DiscoverPeers(t *testing.T) {
 
 	t.Run("pod without workloadRef returns nil", func(t *testing.T) {
 		c := fake.NewClientBuilder().Build()
-		d := NewWorkloadRefDiscoverer(c)
+		d := NewWorkloadRefDiscoverer(c, nil, nil, "")
 
 		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}
 		info, err := d.DiscoverPeers(context.Background(), pod)
@@ -197,3 +336,65 @@ func TestWorkloadRefDiscoverer_DiscoverPeers(t *testing.T) {
 		assert.Nil(t, info)
 	})
 }
+
+func TestWorkloadRefDiscoverer_WorkloadLookupErrors(t *testing.T) {
+	workloadGR := schema.GroupResource{Group: WorkloadGVK.Group, Resource: "workloads"}
+
+	tests := []struct {
+		name      string
+		getErr    error
+		reason    string
+		notReason string
+	}{
+		{
+			name:      "forbidden is reported as an RBAC problem",
+			getErr:    apierrors.NewForbidden(workloadGR, "train", nil),
+			reason:    metrics.WorkloadLookupReasonForbidden,
+			notReason: metrics.WorkloadLookupReasonNotFound,
+		},
+		{
+			name:      "not found is a benign fallback",
+			getErr:    apierrors.NewNotFound(workloadGR, "train"),
+			reason:    metrics.WorkloadLookupReasonNotFound,
+			notReason: metrics.WorkloadLookupReasonForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pods := []runtime.Object{
+				makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning),
+				makeWorkloadPod("w-1", "default", "train", "workers", "10.0.0.2", corev1.PodRunning),
+			}
+
+			c := fake.NewClientBuilder().
+				WithRuntimeObjects(pods...).
+				WithInterceptorFuncs(interceptor.Funcs{
+					Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey,
+						obj client.Object, opts ...client.GetOption) error {
+						if _, ok := obj.(*unstructured.Unstructured); ok {
+							return tt.getErr
+						}
+
+						return cl.Get(ctx, key, obj, opts...)
+					},
+				}).
+				Build()
+			d := NewWorkloadRefDiscoverer(c, nil, nil, "")
+
+			reasonBefore := testutil.ToFloat64(metrics.GangWorkloadLookupErrors.WithLabelValues(tt.reason))
+			otherBefore := testutil.ToFloat64(metrics.GangWorkloadLookupErrors.WithLabelValues(tt.notReason))
+
+			info, err := d.DiscoverPeers(context.Background(),
+				makeWorkloadPod("w-0", "default", "train", "workers", "10.0.0.1", corev1.PodRunning))
+			require.NoError(t, err)
+			require.NotNil(t, info)
+			assert.Equal(t, 2, info.ExpectedMinCount, "should fall back to discovered peer count")
+
+			assert.Equal(t, reasonBefore+1,
+				testutil.ToFloat64(metrics.GangWorkloadLookupErrors.WithLabelValues(tt.reason)))
+			assert.Equal(t, otherBefore,
+				testutil.ToFloat64(metrics.GangWorkloadLookupErrors.WithLabelValues(tt.notReason)))
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discoverer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nvidia/nvsentinel/preflight/pkg/gang/types"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// podListPageSize is the number of pods requested per page when listing a namespace.
+const podListPageSize = 500
+
+// forEachPod lists the pods in namespace one page at a time and calls visit for each of them, so
+// large namespaces are neither returned in a single response nor held in memory at once. c must
+// be a live API reader: the cache ignores continue tokens and truncates lists at the page limit.
+// Listing always reaches the last page, because the expected gang size is only a lower bound, and
+// stops early only between pages when ctx is cancelled.
+func forEachPod(
+	ctx context.Context,
+	c client.Reader,
+	namespace string,
+	visit func(pod *corev1.Pod),
+) error {
+	continueToken := ""
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("listing pods in namespace %s: %w", namespace, err)
+		}
+
+		var page corev1.PodList
+
+		if err := c.List(ctx, &page,
+			client.InNamespace(namespace),
+			client.Limit(podListPageSize),
+			client.Continue(continueToken),
+		); err != nil {
+			return fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
+		}
+
+		for i := range page.Items {
+			visit(&page.Items[i])
+		}
+
+		continueToken = page.Continue
+		if continueToken == "" {
+			return nil
+		}
+	}
+}
+
+// findLabelledPeers lists the pods in namespace whose labelKey equals value and that are
+// accepted by the phase and terminating-pod filters. The label selector keeps the result to one
+// gang, so c is expected to be the cached reader.
+func findLabelledPeers(
+	ctx context.Context,
+	c client.Reader,
+	namespace, labelKey, value string,
+	podPhases podPhaseSet,
+	terminatingPods TerminatingPodPolicy,
+) ([]types.PeerInfo, error) {
+	var podList corev1.PodList
+	if err := c.List(ctx, &podList, client.InNamespace(namespace), client.MatchingLabels{labelKey: value}); err != nil {
+		return nil, fmt.Errorf("failed to list pods with %s=%s in namespace %s: %w", labelKey, value, namespace, err)
+	}
+
+	var peers []types.PeerInfo
+
+	for i := range podList.Items {
+		p := &podList.Items[i]
+		if !isActivePeer(p, podPhases, terminatingPods) {
+			continue
+		}
+
+		peers = append(peers, types.PeerInfo{
+			PodName:   p.Name,
+			PodIP:     p.Status.PodIP,
+			NodeName:  p.Spec.NodeName,
+			Namespace: p.Namespace,
+		})
+	}
+
+	return peers, nil
+}
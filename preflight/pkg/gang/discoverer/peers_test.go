@@ -0,0 +1,193 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discoverer
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// newPagedClient returns a fake client holding objs whose pod lists are served in pages of the
+// requested limit, like the API server. onPage is called with the number of each page served.
+func newPagedClient(objs []runtime.Object, onPage func(page int)) client.Client {
+	pages := 0
+
+	return fake.NewClientBuilder().
+		WithRuntimeObjects(objs...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, cl client.WithWatch, list client.ObjectList,
+				opts ...client.ListOption) error {
+				podList, ok := list.(*corev1.PodList)
+				if !ok {
+					return cl.List(ctx, list, opts...)
+				}
+
+				listOpts := &client.ListOptions{}
+				listOpts.ApplyOptions(opts)
+
+				if err := cl.List(ctx, podList, &client.ListOptions{
+					Namespace:     listOpts.Namespace,
+					LabelSelector: listOpts.LabelSelector,
+				}); err != nil {
+					return err
+				}
+
+				slices.SortFunc(podList.Items, func(a, b corev1.Pod) int {
+					return strings.Compare(a.Name, b.Name)
+				})
+
+				start := 0
+				if listOpts.Continue != "" {
+					start, _ = strconv.Atoi(listOpts.Continue)
+				}
+
+				end := len(podList.Items)
+				if listOpts.Limit > 0 {
+					end = min(start+int(listOpts.Limit), end)
+				}
+
+				podList.Continue = ""
+				if end < len(podList.Items) {
+					podList.Continue = strconv.Itoa(end)
+				}
+
+				podList.Items = podList.Items[start:end]
+
+				pages++
+				onPage(pages)
+
+				return nil
+			},
+		}).
+		Build()
+}
+
+func makeNamespacePods(namespace string, count int) []runtime.Object {
+	pods := make([]runtime.Object, 0, count)
+	for n := range count {
+		pods = append(pods, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("batch-%04d", n), Namespace: namespace},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		})
+	}
+
+	return pods
+}
+
+func TestForEachPod_Paginates(t *testing.T) {
+	objs := append(makeNamespacePods("busy", 2*podListPageSize+34), makeNamespacePods("other", 10)...)
+
+	var pages int
+
+	c := newPagedClient(objs, func(page int) { pages = page })
+
+	var visited []string
+
+	err := forEachPod(context.Background(), c, "busy", func(p *corev1.Pod) {
+		visited = append(visited, p.Name)
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, pages)
+	assert.Len(t, visited, 2*podListPageSize+34)
+	assert.Equal(t, "batch-0000", visited[0])
+	assert.Equal(t, fmt.Sprintf("batch-%04d", 2*podListPageSize+33), visited[len(visited)-1])
+}
+
+func TestForEachPod_CancelledMidPagination(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var pages int
+
+	c := newPagedClient(makeNamespacePods("busy", 3*podListPageSize), func(page int) {
+		pages = page
+		if page == 1 {
+			cancel()
+		}
+	})
+
+	visited := 0
+
+	err := forEachPod(ctx, c, "busy", func(*corev1.Pod) { visited++ })
+	require.ErrorIs(t, err, context.Canceled)
+
+	assert.Equal(t, 1, pages, "no page is requested after cancellation")
+	assert.Equal(t, podListPageSize, visited)
+}
+
+func TestPodGroupDiscoverer_ListsPeersBeyondMinMember(t *testing.T) {
+	objs := []runtime.Object{
+		makePodGroupCRD("default", "big-pg", 2),
+		// minMember peers sort before the batch pods, the extra member after them.
+		makePodInGroup("a-worker-0", "default", "big-pg", "10.0.0.1", corev1.PodRunning),
+		makePodInGroup("a-worker-1", "default", "big-pg", "10.0.0.2", corev1.PodRunning),
+		makePodInGroup("z-worker-2", "default", "big-pg", "10.0.0.3", corev1.PodRunning),
+	}
+	objs = append(objs, makeNamespacePods("default", 3*podListPageSize)...)
+
+	var pages int
+
+	c := newPagedClient(objs, func(page int) { pages = page })
+	d, err := NewPodGroupDiscoverer(c, nil, testConfig())
+	require.NoError(t, err)
+
+	info, err := d.DiscoverPeers(context.Background(),
+		makePodInGroup("a-worker-0", "default", "big-pg", "10.0.0.1", corev1.PodRunning))
+	require.NoError(t, err)
+	require.NotNil(t, info)
+
+	assert.Equal(t, 4, pages, "minMember is a lower bound, so listing reaches the last page")
+	assert.Len(t, info.Peers, 3)
+	assert.Equal(t, 2, info.ExpectedMinCount)
+}
+
+func TestPodGroupDiscoverer_DiscoverPeersInLargeNamespace(t *testing.T) {
+	objs := append(makeNamespacePods("default", 2*podListPageSize),
+		makePodGroupCRD("default", "big-pg", 3),
+		// Sorted after the batch pods, so the gang spans the last pages.
+		makePodInGroup("worker-0", "default", "big-pg", "10.0.0.1", corev1.PodRunning),
+		makePodInGroup("worker-1", "default", "big-pg", "10.0.0.2", corev1.PodRunning),
+		makePodInGroup("worker-2", "default", "big-pg", "10.0.0.3", corev1.PodRunning),
+	)
+
+	var pages int
+
+	c := newPagedClient(objs, func(page int) { pages = page })
+	d, err := NewPodGroupDiscoverer(c, nil, testConfig())
+	require.NoError(t, err)
+
+	info, err := d.DiscoverPeers(context.Background(),
+		makePodInGroup("worker-0", "default", "big-pg", "10.0.0.1", corev1.PodRunning))
+	require.NoError(t, err)
+	require.NotNil(t, info)
+
+	assert.Equal(t, 3, pages)
+	assert.Len(t, info.Peers, 3)
+	assert.Equal(t, 3, info.ExpectedMinCount)
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discoverer
+
+import (
+	"log/slog"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DefaultPodPhases are the pod phases accepted as gang peers when none are configured.
+var DefaultPodPhases = []corev1.PodPhase{corev1.PodRunning, corev1.PodPending}
+
+// podPhaseSet is the set of pod phases a discoverer accepts as gang peers.
+type podPhaseSet map[corev1.PodPhase]bool
+
+// newPodPhaseSet builds the accepted phase set, falling back to DefaultPodPhases.
+func newPodPhaseSet(phases []corev1.PodPhase) podPhaseSet {
+	if len(phases) == 0 {
+		phases = DefaultPodPhases
+	}
+
+	set := make(podPhaseSet, len(phases))
+	for _, phase := range phases {
+		set[phase] = true
+	}
+
+	return set
+}
+
+// accepts returns true if the pod's phase is in the set.
+func (s podPhaseSet) accepts(pod *corev1.Pod) bool {
+	return s[pod.Status.Phase]
+}
+
+// TerminatingPodPolicy controls whether pods that are being deleted are accepted as gang peers.
+// A terminating pod keeps its phase (usually Running) until its containers exit, so it may still
+// be holding the node's GPUs.
+type TerminatingPodPolicy string
+
+const (
+	// TerminatingPodsInclude accepts terminating pods that have started and logs them, for
+	// workloads whose pods hold their GPUs well past deletion.
+	TerminatingPodsInclude TerminatingPodPolicy = "Include"
+	// TerminatingPodsExclude skips terminating pods regardless of their phase. This is the default:
+	// a pod that is going away will not take part in the check.
+	TerminatingPodsExclude TerminatingPodPolicy = "Exclude"
+)
+
+// accepts returns true if the pod is not terminating or the policy includes terminating pods.
+func (p TerminatingPodPolicy) accepts(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp == nil {
+		return true
+	}
+
+	if p != TerminatingPodsInclude {
+		return false
+	}
+
+	slog.Warn("Including terminating pod as gang peer",
+		"pod", pod.Name,
+		"namespace", pod.Namespace,
+		"node", pod.Spec.NodeName,
+		"phase", pod.Status.Phase)
+
+	return true
+}
+
+// isActivePeer returns true if the pod counts as a gang peer. Besides the accepted phases and the
+// terminating-pod policy, a terminating pod that is still Pending is never a peer, even with
+// TerminatingPodsInclude: it never started and is going away, so counting it would only inflate
+// the gang size.
+func isActivePeer(pod *corev1.Pod, podPhases podPhaseSet, terminatingPods TerminatingPodPolicy) bool {
+	if pod.DeletionTimestamp != nil && pod.Status.Phase == corev1.PodPending {
+		return false
+	}
+
+	return podPhases.accepts(pod) && terminatingPods.accepts(pod)
+}
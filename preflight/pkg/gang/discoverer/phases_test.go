@@ -0,0 +1,84 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discoverer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsActivePeer(t *testing.T) {
+	makePod := func(phase corev1.PodPhase, terminating bool) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker-0", Namespace: "default"},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+		if terminating {
+			pod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		}
+
+		return pod
+	}
+
+	tests := []struct {
+		name            string
+		pod             *corev1.Pod
+		podPhases       []corev1.PodPhase
+		terminatingPods TerminatingPodPolicy
+		expected        bool
+	}{
+		{name: "running", pod: makePod(corev1.PodRunning, false), expected: true},
+		{name: "pending", pod: makePod(corev1.PodPending, false), expected: true},
+		{name: "succeeded", pod: makePod(corev1.PodSucceeded, false), expected: false},
+		{name: "failed", pod: makePod(corev1.PodFailed, false), expected: false},
+		{name: "terminating running excluded by default", pod: makePod(corev1.PodRunning, true), expected: false},
+		{
+			name:            "terminating running excluded by policy",
+			pod:             makePod(corev1.PodRunning, true),
+			terminatingPods: TerminatingPodsExclude,
+			expected:        false,
+		},
+		{
+			name:            "terminating running included by policy",
+			pod:             makePod(corev1.PodRunning, true),
+			terminatingPods: TerminatingPodsInclude,
+			expected:        true,
+		},
+		{name: "terminating pending", pod: makePod(corev1.PodPending, true), expected: false},
+		{
+			name:            "terminating pending with include policy",
+			pod:             makePod(corev1.PodPending, true),
+			terminatingPods: TerminatingPodsInclude,
+			expected:        false,
+		},
+		{name: "terminating succeeded", pod: makePod(corev1.PodSucceeded, true), expected: false},
+		{
+			name:      "succeeded when configured",
+			pod:       makePod(corev1.PodSucceeded, false),
+			podPhases: []corev1.PodPhase{corev1.PodSucceeded},
+			expected:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isActivePeer(tt.pod, newPodPhaseSet(tt.podPhases), tt.terminatingPods))
+		})
+	}
+}
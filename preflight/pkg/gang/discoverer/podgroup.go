@@ -29,6 +29,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// PodGroupIndexField is the pod field index holding the pod group name read from a pod's
+// configured annotation or label keys.
+const PodGroupIndexField = "podGroupName"
+
 // PodGroupConfig defines the configuration for a PodGroup-based gang discoverer.
 type PodGroupConfig struct {
 	// Name is the discoverer name (e.g., "volcano").
@@ -44,21 +48,36 @@ type PodGroupConfig struct {
 	PodGroupGVK schema.GroupVersionKind
 
 	// MinCountExpr is a CEL expression to extract minCount from PodGroup.
-	// Receives 'podGroup' as map[string]any.
+	// Receives 'podGroup' as map[string]any and must evaluate to a number.
+	// When evaluation fails or yields a non-positive count, the discovered peer count is used.
 	MinCountExpr string
+
+	// PodPhases are the pod phases accepted as gang peers.
+	// Defaults to DefaultPodPhases (Running and Pending) when empty.
+	PodPhases []corev1.PodPhase
+
+	// TerminatingPods controls whether terminating pods are accepted as gang peers.
+	// Defaults to TerminatingPodsExclude when empty.
+	TerminatingPods TerminatingPodPolicy
 }
 
 // PodGroupDiscoverer discovers gang members using PodGroup CRDs.
 // This is a generic implementation that works with Volcano and similar PodGroup-based schedulers.
 type PodGroupDiscoverer struct {
-	client          client.Client
+	client          client.Reader
+	podCache        client.Reader
 	config          PodGroupConfig
 	minCountProgram cel.Program
+	podPhases       podPhaseSet
 }
 
 // NewPodGroupDiscoverer creates a new PodGroup-based gang discoverer.
+// podCache is an optional informer cache with the PodGroupIndexField pod index (see IndexPods);
+// when set, peers are read from the index and c is only used to read PodGroups. Without it, c
+// must be a live API reader, because peers are found by paging through the whole namespace.
 func NewPodGroupDiscoverer(
-	c client.Client,
+	c client.Reader,
+	podCache client.Reader,
 	config PodGroupConfig,
 ) (*PodGroupDiscoverer, error) {
 	// Compile CEL expression for minCount extraction
@@ -74,6 +93,11 @@ func NewPodGroupDiscoverer(
 		return nil, fmt.Errorf("failed to compile minCountExpr %q: %w", config.MinCountExpr, issues.Err())
 	}
 
+	if !isNumericOutput(ast.OutputType()) {
+		return nil, fmt.Errorf("minCountExpr %q must evaluate to a number, got %s",
+			config.MinCountExpr, ast.OutputType())
+	}
+
 	program, err := env.Program(ast)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create CEL program: %w", err)
@@ -81,8 +105,10 @@ func NewPodGroupDiscoverer(
 
 	return &PodGroupDiscoverer{
 		client:          c,
+		podCache:        podCache,
 		config:          config,
 		minCountProgram: program,
+		podPhases:       newPodPhaseSet(config.PodPhases),
 	}, nil
 }
 
@@ -151,38 +177,17 @@ func (d *PodGroupDiscoverer) DiscoverPeers(ctx context.Context, pod *corev1.Pod)
 		"gangID", gangID)
 
 	// Get expected size from PodGroup CRD - required for correct gang coordination
-	expectedCount, err := d.getPodGroupMinMember(ctx, pod.Namespace, podGroupName)
+	podGroup, err := d.getPodGroup(ctx, pod.Namespace, podGroupName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get PodGroup %s/%s minMember (check RBAC): %w",
 			pod.Namespace, podGroupName, err)
 	}
 
-	var podList corev1.PodList
-	if err := d.client.List(ctx, &podList, client.InNamespace(pod.Namespace)); err != nil {
-		return nil, fmt.Errorf("failed to list pods in namespace %s: %w", pod.Namespace, err)
-	}
-
-	var peers []types.PeerInfo
-
-	for i := range podList.Items {
-		p := &podList.Items[i]
+	expectedCount := d.fetchExpectedMinCount(podGroup)
 
-		// Check if this pod belongs to the same gang
-		if d.getPodGroupName(p) != podGroupName {
-			continue
-		}
-
-		// Skip pods that are not running or pending
-		if p.Status.Phase != corev1.PodRunning && p.Status.Phase != corev1.PodPending {
-			continue
-		}
-
-		peers = append(peers, types.PeerInfo{
-			PodName:   p.Name,
-			PodIP:     p.Status.PodIP,
-			NodeName:  p.Spec.NodeName,
-			Namespace: p.Namespace,
-		})
+	peers, err := d.findPeers(ctx, pod.Namespace, podGroupName)
+	if err != nil {
+		return nil, err
 	}
 
 	if len(peers) == 0 {
@@ -195,6 +200,10 @@ func (d *PodGroupDiscoverer) DiscoverPeers(ctx context.Context, pod *corev1.Pod)
 		return nil, nil
 	}
 
+	if expectedCount == 0 {
+		expectedCount = len(peers)
+	}
+
 	slog.Info("Discovered gang",
 		"discoverer", d.config.Name,
 		"gangID", gangID,
@@ -209,18 +218,123 @@ func (d *PodGroupDiscoverer) DiscoverPeers(ctx context.Context, pod *corev1.Pod)
 	}, nil
 }
 
-// getPodGroupMinMember retrieves the minMember field from a PodGroup CRD using CEL.
-func (d *PodGroupDiscoverer) getPodGroupMinMember(
+// findPeers lists the active pods of the pod group, from the pod index when a cache is
+// configured and otherwise from the whole namespace.
+func (d *PodGroupDiscoverer) findPeers(
+	ctx context.Context,
+	namespace, podGroupName string,
+) ([]types.PeerInfo, error) {
+	var peers []types.PeerInfo
+
+	visit := func(p *corev1.Pod) {
+		// Check if this pod belongs to the same gang
+		if d.getPodGroupName(p) != podGroupName {
+			return
+		}
+
+		// Skip pods that are not active gang peers
+		if !isActivePeer(p, d.podPhases, d.config.TerminatingPods) {
+			return
+		}
+
+		peers = append(peers, types.PeerInfo{
+			PodName:   p.Name,
+			PodIP:     p.Status.PodIP,
+			NodeName:  p.Spec.NodeName,
+			Namespace: p.Namespace,
+		})
+	}
+
+	if d.podCache == nil {
+		if err := forEachPod(ctx, d.client, namespace, visit); err != nil {
+			return nil, err
+		}
+
+		return peers, nil
+	}
+
+	var podList corev1.PodList
+	if err := d.podCache.List(ctx, &podList,
+		client.InNamespace(namespace), client.MatchingFields{PodGroupIndexField: podGroupName}); err != nil {
+		return nil, fmt.Errorf("failed to list pods of pod group %s/%s from cache: %w", namespace, podGroupName, err)
+	}
+
+	for i := range podList.Items {
+		visit(&podList.Items[i])
+	}
+
+	return peers, nil
+}
+
+// IndexPods registers the PodGroupIndexField pod index on indexer, keyed by the pod group name
+// read from the discoverer's annotation and label keys.
+func (d *PodGroupDiscoverer) IndexPods(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &corev1.Pod{}, PodGroupIndexField, d.podGroupIndexer); err != nil {
+		return fmt.Errorf("failed to add indexer on Pods for %s: %w", PodGroupIndexField, err)
+	}
+
+	return nil
+}
+
+func (d *PodGroupDiscoverer) podGroupIndexer(obj client.Object) []string {
+	p, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil
+	}
+
+	name := d.getPodGroupName(p)
+	if name == "" {
+		return nil
+	}
+
+	return []string{name}
+}
+
+// getPodGroup retrieves the PodGroup CRD backing a gang.
+func (d *PodGroupDiscoverer) getPodGroup(
 	ctx context.Context,
 	namespace, name string,
-) (int, error) {
+) (*unstructured.Unstructured, error) {
 	podGroup := &unstructured.Unstructured{}
 	podGroup.SetGroupVersionKind(d.config.PodGroupGVK)
 
 	if err := d.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, podGroup); err != nil {
-		return 0, fmt.Errorf("failed to get PodGroup %s/%s: %w", namespace, name, err)
+		return nil, fmt.Errorf("failed to get PodGroup %s/%s: %w", namespace, name, err)
 	}
 
+	return podGroup, nil
+}
+
+// fetchExpectedMinCount evaluates minCountExpr against the PodGroup, logging any errors.
+// Returns 0 when the expression fails or yields a non-positive count, so the caller
+// falls back to the discovered pod count.
+func (d *PodGroupDiscoverer) fetchExpectedMinCount(podGroup *unstructured.Unstructured) int {
+	count, err := d.evalMinCount(podGroup)
+	if err != nil {
+		slog.Warn("Failed to evaluate PodGroup minCount, will use discovered pod count",
+			"discoverer", d.config.Name,
+			"podGroup", podGroup.GetName(),
+			"namespace", podGroup.GetNamespace(),
+			"error", err)
+
+		return 0
+	}
+
+	if count <= 0 {
+		slog.Warn("PodGroup minCount is not positive, will use discovered pod count",
+			"discoverer", d.config.Name,
+			"podGroup", podGroup.GetName(),
+			"namespace", podGroup.GetNamespace(),
+			"minCount", count)
+
+		return 0
+	}
+
+	return count
+}
+
+// evalMinCount extracts the minimum member count from a PodGroup using CEL.
+func (d *PodGroupDiscoverer) evalMinCount(podGroup *unstructured.Unstructured) (int, error) {
 	result, _, err := d.minCountProgram.Eval(map[string]any{
 		"podGroup": podGroup.Object,
 	})
@@ -232,6 +346,8 @@ func (d *PodGroupDiscoverer) getPodGroupMinMember(
 	switch v := result.Value().(type) {
 	case int64:
 		return int(v), nil
+	case uint64:
+		return int(v), nil
 	case float64:
 		return int(v), nil
 	case int:
@@ -240,3 +356,15 @@ func (d *PodGroupDiscoverer) getPodGroupMinMember(
 		return 0, fmt.Errorf("minCountExpr %q returned non-numeric type %T", d.config.MinCountExpr, v)
 	}
 }
+
+// isNumericOutput reports whether a compiled minCountExpr can yield a number.
+// Field selections on the podGroup map are dynamically typed and checked at evaluation time.
+func isNumericOutput(t *cel.Type) bool {
+	for _, numeric := range []*cel.Type{cel.IntType, cel.UintType, cel.DoubleType, cel.DynType} {
+		if t.IsExactType(numeric) {
+			return true
+		}
+	}
+
+	return false
+}
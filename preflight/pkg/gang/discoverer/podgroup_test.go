@@ -17,6 +17,7 @@ package discoverer
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,7 +26,9 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 func testConfig() PodGroupConfig {
@@ -83,7 +86,7 @@ func TestPodGroupDiscoverer_CanHandle(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d, err := NewPodGroupDiscoverer(nil, tt.config)
+			d, err := NewPodGroupDiscoverer(nil, nil, tt.config)
 			if err != nil {
 				t.Fatalf("NewPodGroupDiscoverer() error = %v", err)
 			}
@@ -125,7 +128,7 @@ func TestPodGroupDiscoverer_ExtractGangID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			d, err := NewPodGroupDiscoverer(nil, tt.config)
+			d, err := NewPodGroupDiscoverer(nil, nil, tt.config)
 			if err != nil {
 				t.Fatalf("NewPodGroupDiscoverer() error = %v", err)
 			}
@@ -137,6 +140,38 @@ func TestPodGroupDiscoverer_ExtractGangID(t *testing.T) {
 	}
 }
 
+func TestNewPodGroupDiscoverer_MinCountExpr(t *testing.T) {
+	tests := []struct {
+		name         string
+		minCountExpr string
+		wantErr      string
+	}{
+		{name: "field selection", minCountExpr: "podGroup.spec.minMember"},
+		{name: "arithmetic", minCountExpr: "podGroup.spec.minMember + 1"},
+		{name: "malformed expression", minCountExpr: "podGroup.spec.", wantErr: "failed to compile minCountExpr"},
+		{name: "unknown variable", minCountExpr: "pg.spec.minMember", wantErr: "failed to compile minCountExpr"},
+		{name: "non-numeric result", minCountExpr: "'eight'", wantErr: "must evaluate to a number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := testConfig()
+			cfg.MinCountExpr = tt.minCountExpr
+
+			d, err := NewPodGroupDiscoverer(fake.NewClientBuilder().Build(), nil, cfg)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, d)
+		})
+	}
+}
+
 // --- DiscoverPeers tests ---
 
 func makePodGroupCRD(namespace, name string, minMember int64) *unstructured.Unstructured {
@@ -187,7 +222,7 @@ func TestPodGroupDiscoverer_DiscoverPeers(t *testing.T) {
 		c := fake.NewClientBuilder().WithRuntimeObjects(append(pods, pg)...).Build()
 		cfg := testConfig()
 		cfg.PodGroupGVK = pgGVK
-		d, err := NewPodGroupDiscoverer(c, cfg)
+		d, err := NewPodGroupDiscoverer(c, nil, cfg)
 		require.NoError(t, err)
 
 		requestPod := makePodInGroup("pod-0", "default", "my-pg", "10.0.0.1", corev1.PodRunning)
@@ -210,7 +245,7 @@ func TestPodGroupDiscoverer_DiscoverPeers(t *testing.T) {
 		c := fake.NewClientBuilder().WithRuntimeObjects(append(pods, pg)...).Build()
 		cfg := testConfig()
 		cfg.PodGroupGVK = pgGVK
-		d, err := NewPodGroupDiscoverer(c, cfg)
+		d, err := NewPodGroupDiscoverer(c, nil, cfg)
 		require.NoError(t, err)
 
 		info, err := d.DiscoverPeers(context.Background(), makePodInGroup("p-running", "default", "phase-pg", "10.0.0.1", corev1.PodRunning))
@@ -219,6 +254,81 @@ func TestPodGroupDiscoverer_DiscoverPeers(t *testing.T) {
 		assert.Len(t, info.Peers, 2, "only Running and Pending should be included")
 	})
 
+	t.Run("configured phases include Succeeded", func(t *testing.T) {
+		pg := makePodGroupCRD("default", "succeeded-pg", 3)
+		pods := []runtime.Object{
+			makePodInGroup("p-running", "default", "succeeded-pg", "10.0.0.1", corev1.PodRunning),
+			makePodInGroup("p-pending", "default", "succeeded-pg", "10.0.0.2", corev1.PodPending),
+			makePodInGroup("p-succeeded", "default", "succeeded-pg", "10.0.0.3", corev1.PodSucceeded),
+			makePodInGroup("p-failed", "default", "succeeded-pg", "10.0.0.4", corev1.PodFailed),
+		}
+
+		c := fake.NewClientBuilder().WithRuntimeObjects(append(pods, pg)...).Build()
+		cfg := testConfig()
+		cfg.PodGroupGVK = pgGVK
+		cfg.PodPhases = []corev1.PodPhase{corev1.PodRunning, corev1.PodPending, corev1.PodSucceeded}
+		d, err := NewPodGroupDiscoverer(c, nil, cfg)
+		require.NoError(t, err)
+
+		info, err := d.DiscoverPeers(context.Background(), makePodInGroup("p-running", "default", "succeeded-pg", "10.0.0.1", corev1.PodRunning))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Len(t, info.Peers, 3, "Running, Pending and Succeeded should be included")
+	})
+
+	t.Run("configured phases exclude Pending", func(t *testing.T) {
+		pg := makePodGroupCRD("default", "running-pg", 2)
+		pods := []runtime.Object{
+			makePodInGroup("p-running", "default", "running-pg", "10.0.0.1", corev1.PodRunning),
+			makePodInGroup("p-pending", "default", "running-pg", "10.0.0.2", corev1.PodPending),
+		}
+
+		c := fake.NewClientBuilder().WithRuntimeObjects(append(pods, pg)...).Build()
+		cfg := testConfig()
+		cfg.PodGroupGVK = pgGVK
+		cfg.PodPhases = []corev1.PodPhase{corev1.PodRunning}
+		d, err := NewPodGroupDiscoverer(c, nil, cfg)
+		require.NoError(t, err)
+
+		info, err := d.DiscoverPeers(context.Background(), makePodInGroup("p-running", "default", "running-pg", "10.0.0.1", corev1.PodRunning))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		require.Len(t, info.Peers, 1, "Pending should be excluded")
+		assert.Equal(t, "p-running", info.Peers[0].PodName)
+	})
+
+	for _, tc := range []struct {
+		policy    TerminatingPodPolicy
+		wantPeers int
+	}{
+		{policy: "", wantPeers: 1},
+		{policy: TerminatingPodsInclude, wantPeers: 2},
+		{policy: TerminatingPodsExclude, wantPeers: 1},
+	} {
+		t.Run("terminating peer with policy "+string(tc.policy), func(t *testing.T) {
+			pg := makePodGroupCRD("default", "terminating-pg", 2)
+			terminating := makePodInGroup("p-terminating", "default", "terminating-pg", "10.0.0.2", corev1.PodRunning)
+			terminating.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			terminating.Finalizers = []string{"test.io/hold"}
+			pods := []runtime.Object{
+				makePodInGroup("p-running", "default", "terminating-pg", "10.0.0.1", corev1.PodRunning),
+				terminating,
+			}
+
+			c := fake.NewClientBuilder().WithRuntimeObjects(append(pods, pg)...).Build()
+			cfg := testConfig()
+			cfg.PodGroupGVK = pgGVK
+			cfg.TerminatingPods = tc.policy
+			d, err := NewPodGroupDiscoverer(c, nil, cfg)
+			require.NoError(t, err)
+
+			info, err := d.DiscoverPeers(context.Background(), makePodInGroup("p-running", "default", "terminating-pg", "10.0.0.1", corev1.PodRunning))
+			require.NoError(t, err)
+			require.NotNil(t, info)
+			assert.Len(t, info.Peers, tc.wantPeers)
+		})
+	}
+
 	t.Run("extracts minCount via CEL", func(t *testing.T) {
 		pg := makePodGroupCRD("default", "cel-pg", 8)
 		pods := []runtime.Object{
@@ -228,7 +338,7 @@ func TestPodGroupDiscoverer_DiscoverPeers(t *testing.T) {
 		c := fake.NewClientBuilder().WithRuntimeObjects(append(pods, pg)...).Build()
 		cfg := testConfig()
 		cfg.PodGroupGVK = pgGVK
-		d, err := NewPodGroupDiscoverer(c, cfg)
+		d, err := NewPodGroupDiscoverer(c, nil, cfg)
 		require.NoError(t, err)
 
 		info, err := d.DiscoverPeers(context.Background(), makePodInGroup("pod-0", "default", "cel-pg", "10.0.0.1", corev1.PodRunning))
@@ -237,13 +347,42 @@ func TestPodGroupDiscoverer_DiscoverPeers(t *testing.T) {
 		assert.Equal(t, 8, info.ExpectedMinCount)
 	})
 
+	for _, tc := range []struct {
+		name         string
+		minMember    int64
+		minCountExpr string
+	}{
+		{name: "minCount of zero falls back to peer count", minMember: 0, minCountExpr: "podGroup.spec.minMember"},
+		{name: "minCount evaluation error falls back to peer count", minMember: 4, minCountExpr: "podGroup.spec.missing"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pg := makePodGroupCRD("default", "fallback-pg", tc.minMember)
+			pods := []runtime.Object{
+				makePodInGroup("pod-0", "default", "fallback-pg", "10.0.0.1", corev1.PodRunning),
+				makePodInGroup("pod-1", "default", "fallback-pg", "10.0.0.2", corev1.PodRunning),
+			}
+
+			c := fake.NewClientBuilder().WithRuntimeObjects(append(pods, pg)...).Build()
+			cfg := testConfig()
+			cfg.PodGroupGVK = pgGVK
+			cfg.MinCountExpr = tc.minCountExpr
+			d, err := NewPodGroupDiscoverer(c, nil, cfg)
+			require.NoError(t, err)
+
+			info, err := d.DiscoverPeers(context.Background(), makePodInGroup("pod-0", "default", "fallback-pg", "10.0.0.1", corev1.PodRunning))
+			require.NoError(t, err)
+			require.NotNil(t, info)
+			assert.Equal(t, 2, info.ExpectedMinCount)
+		})
+	}
+
 	t.Run("returns nil when no peers", func(t *testing.T) {
 		pg := makePodGroupCRD("default", "empty-pg", 2)
 
 		c := fake.NewClientBuilder().WithRuntimeObjects(pg).Build()
 		cfg := testConfig()
 		cfg.PodGroupGVK = pgGVK
-		d, err := NewPodGroupDiscoverer(c, cfg)
+		d, err := NewPodGroupDiscoverer(c, nil, cfg)
 		require.NoError(t, err)
 
 		// The requesting pod is not in the fake client's pod list
@@ -261,7 +400,7 @@ func TestPodGroupDiscoverer_DiscoverPeers(t *testing.T) {
 		c := fake.NewClientBuilder().WithRuntimeObjects(pods...).Build()
 		cfg := testConfig()
 		cfg.PodGroupGVK = pgGVK
-		d, err := NewPodGroupDiscoverer(c, cfg)
+		d, err := NewPodGroupDiscoverer(c, nil, cfg)
 		require.NoError(t, err)
 
 		_, err = d.DiscoverPeers(context.Background(), makePodInGroup("pod-0", "default", "missing-pg", "10.0.0.1", corev1.PodRunning))
@@ -273,7 +412,7 @@ func TestPodGroupDiscoverer_DiscoverPeers(t *testing.T) {
 		c := fake.NewClientBuilder().Build()
 		cfg := testConfig()
 		cfg.PodGroupGVK = pgGVK
-		d, err := NewPodGroupDiscoverer(c, cfg)
+		d, err := NewPodGroupDiscoverer(c, nil, cfg)
 		require.NoError(t, err)
 
 		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}
@@ -282,3 +421,69 @@ func TestPodGroupDiscoverer_DiscoverPeers(t *testing.T) {
 		assert.Nil(t, info)
 	})
 }
+
+func TestPodGroupDiscoverer_PodIndexMatchesLiveList(t *testing.T) {
+	labelled := makePodInGroup("l-0", "default", "", "10.0.0.5", corev1.PodRunning)
+	labelled.Annotations = nil
+	labelled.Labels = map[string]string{"test.io/job-name": "train"}
+
+	objs := []runtime.Object{
+		makePodGroupCRD("default", "train", 2),
+		makePodGroupCRD("default", "eval", 1),
+		makePodGroupCRD("team-b", "train", 1),
+		makePodInGroup("w-0", "default", "train", "10.0.0.1", corev1.PodRunning),
+		makePodInGroup("w-1", "default", "train", "10.0.0.2", corev1.PodPending),
+		makePodInGroup("w-2", "default", "train", "10.0.0.3", corev1.PodFailed),
+		makePodInGroup("w-3", "default", "train", "10.0.0.4", corev1.PodRunning),
+		labelled,
+		makePodInGroup("e-0", "default", "eval", "10.0.0.6", corev1.PodRunning),
+		makePodInGroup("w-0", "team-b", "train", "10.0.1.1", corev1.PodRunning),
+		makePodInGroup("plain", "default", "", "10.0.0.7", corev1.PodRunning),
+	}
+
+	live, err := NewPodGroupDiscoverer(fake.NewClientBuilder().WithRuntimeObjects(objs...).Build(), nil, testConfig())
+	require.NoError(t, err)
+
+	// The index function is a method of the discoverer, so build it before the cache that uses it.
+	indexed, err := NewPodGroupDiscoverer(fake.NewClientBuilder().WithRuntimeObjects(objs...).Build(), nil, testConfig())
+	require.NoError(t, err)
+
+	indexed.podCache = fake.NewClientBuilder().
+		WithRuntimeObjects(objs...).
+		WithIndex(&corev1.Pod{}, PodGroupIndexField, indexed.podGroupIndexer).
+		WithInterceptorFuncs(interceptor.Funcs{
+			List: func(ctx context.Context, cl client.WithWatch, list client.ObjectList,
+				opts ...client.ListOption) error {
+				listOpts := &client.ListOptions{}
+				listOpts.ApplyOptions(opts)
+
+				if listOpts.FieldSelector == nil {
+					t.Errorf("pod cache listed without the %s index", PodGroupIndexField)
+				}
+
+				return cl.List(ctx, list, opts...)
+			},
+		}).
+		Build()
+
+	for _, pod := range []*corev1.Pod{
+		makePodInGroup("w-0", "default", "train", "10.0.0.1", corev1.PodRunning),
+		labelled,
+		makePodInGroup("e-0", "default", "eval", "10.0.0.6", corev1.PodRunning),
+		makePodInGroup("w-0", "team-b", "train", "10.0.1.1", corev1.PodRunning),
+	} {
+		name := pod.Namespace + "/" + pod.Name
+
+		want, err := live.DiscoverPeers(context.Background(), pod)
+		require.NoError(t, err, name)
+		require.NotNil(t, want, name)
+
+		got, err := indexed.DiscoverPeers(context.Background(), pod)
+		require.NoError(t, err, name)
+		require.NotNil(t, got, name)
+
+		assert.Equal(t, want.GangID, got.GangID, name)
+		assert.Equal(t, want.ExpectedMinCount, got.ExpectedMinCount, name)
+		assert.ElementsMatch(t, want.Peers, got.Peers, name)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discoverer
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/nvidia/nvsentinel/preflight/pkg/gang/types"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RayClusterLabel is the label KubeRay sets on every head and worker pod of a RayCluster.
+const RayClusterLabel = "ray.io/cluster"
+
+// RayClusterGVK is the GroupVersionKind for KubeRay RayCluster resources.
+var RayClusterGVK = schema.GroupVersionKind{
+	Group:   "ray.io",
+	Version: "v1",
+	Kind:    "RayCluster",
+}
+
+// RayClusterDiscoverer discovers gang members of a Ray cluster. All pods carrying the
+// same ray.io/cluster label form one gang. When lookupClusterSize is set, the expected
+// size is read from the RayCluster (one head plus the replicas of every worker group);
+// otherwise the discovered pod count is used.
+type RayClusterDiscoverer struct {
+	client            client.Reader
+	lookupClusterSize bool
+	podPhases         podPhaseSet
+	terminatingPods   TerminatingPodPolicy
+}
+
+// NewRayClusterDiscoverer creates a new Ray cluster gang discoverer.
+// lookupClusterSize reads the expected gang size from the RayCluster resource.
+// podPhases are the pod phases accepted as gang peers; empty means DefaultPodPhases.
+// terminatingPods controls whether terminating pods are peers; empty means TerminatingPodsExclude.
+func NewRayClusterDiscoverer(
+	c client.Reader,
+	lookupClusterSize bool,
+	podPhases []corev1.PodPhase,
+	terminatingPods TerminatingPodPolicy,
+) *RayClusterDiscoverer {
+	return &RayClusterDiscoverer{
+		client:            c,
+		lookupClusterSize: lookupClusterSize,
+		podPhases:         newPodPhaseSet(podPhases),
+		terminatingPods:   terminatingPods,
+	}
+}
+
+func (r *RayClusterDiscoverer) Name() string {
+	return "ray"
+}
+
+// CanHandle returns true if the pod belongs to a Ray cluster.
+func (r *RayClusterDiscoverer) CanHandle(pod *corev1.Pod) bool {
+	return getRayClusterName(pod) != ""
+}
+
+// ExtractGangID extracts the gang identifier from a pod's Ray cluster label.
+func (r *RayClusterDiscoverer) ExtractGangID(pod *corev1.Pod) string {
+	clusterName := getRayClusterName(pod)
+	if clusterName == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("ray-%s-%s", pod.Namespace, clusterName)
+}
+
+// DiscoverPeers finds all pods of the same Ray cluster.
+func (r *RayClusterDiscoverer) DiscoverPeers(ctx context.Context, pod *corev1.Pod) (*types.GangInfo, error) {
+	if !r.CanHandle(pod) {
+		return nil, nil
+	}
+
+	clusterName := getRayClusterName(pod)
+	gangID := r.ExtractGangID(pod)
+
+	slog.Info("Discovering Ray cluster gang",
+		"pod", pod.Name,
+		"namespace", pod.Namespace,
+		"rayCluster", clusterName,
+		"gangID", gangID)
+
+	peers, err := findLabelledPeers(ctx, r.client, pod.Namespace, RayClusterLabel, clusterName,
+		r.podPhases, r.terminatingPods)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(peers) == 0 {
+		slog.Warn("No peers found for Ray cluster gang",
+			"pod", pod.Name,
+			"rayCluster", clusterName,
+			"gangID", gangID)
+
+		return nil, nil
+	}
+
+	expectedMinCount := len(peers)
+
+	if r.lookupClusterSize {
+		size, err := r.getRayClusterSize(ctx, pod.Namespace, clusterName)
+		if err != nil {
+			slog.Warn("Failed to get RayCluster size, will use discovered pod count",
+				"rayCluster", clusterName,
+				"namespace", pod.Namespace,
+				"error", err)
+		} else {
+			expectedMinCount = size
+		}
+	}
+
+	slog.Info("Discovered Ray cluster gang",
+		"gangID", gangID,
+		"rayCluster", clusterName,
+		"expectedCount", expectedMinCount,
+		"discoveredPeers", len(peers))
+
+	return &types.GangInfo{
+		GangID:           gangID,
+		ExpectedMinCount: expectedMinCount,
+		Peers:            peers,
+	}, nil
+}
+
+// getRayClusterSize returns the head pod plus the replicas of every worker group.
+func (r *RayClusterDiscoverer) getRayClusterSize(ctx context.Context, namespace, name string) (int, error) {
+	rayCluster := &unstructured.Unstructured{}
+	rayCluster.SetGroupVersionKind(RayClusterGVK)
+
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, rayCluster); err != nil {
+		return 0, fmt.Errorf("failed to get RayCluster %s/%s: %w", namespace, name, err)
+	}
+
+	workerGroups, _, err := unstructured.NestedSlice(rayCluster.Object, "spec", "workerGroupSpecs")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workerGroupSpecs from RayCluster %s/%s: %w", namespace, name, err)
+	}
+
+	total := 1 // head
+
+	for _, wgRaw := range workerGroups {
+		wg, ok := wgRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		// KubeRay falls back to minReplicas when replicas is unset
+		replicas, found, _ := unstructured.NestedInt64(wg, "replicas")
+		if !found {
+			replicas, _, _ = unstructured.NestedInt64(wg, "minReplicas")
+		}
+
+		total += int(replicas)
+	}
+
+	return total, nil
+}
+
+// getRayClusterName extracts the Ray cluster label from a pod.
+// Returns empty string if not present.
+func getRayClusterName(pod *corev1.Pod) string {
+	return pod.Labels[RayClusterLabel]
+}
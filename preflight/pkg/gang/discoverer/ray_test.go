@@ -0,0 +1,185 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discoverer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRayClusterDiscoverer_CanHandle(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want bool
+	}{
+		{
+			name: "matches ray cluster label",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{RayClusterLabel: "raycluster-a"},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "no ray cluster label",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"some-label": "value"},
+				},
+			},
+			want: false,
+		},
+	}
+
+	d := NewRayClusterDiscoverer(nil, false, nil, "")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.CanHandle(tt.pod); got != tt.want {
+				t.Errorf("CanHandle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRayClusterDiscoverer_ExtractGangID(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want string
+	}{
+		{
+			name: "gang ID format",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Labels:    map[string]string{RayClusterLabel: "raycluster-a"},
+				},
+			},
+			want: "ray-default-raycluster-a",
+		},
+		{
+			name: "no ray cluster label returns empty",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test"},
+			},
+			want: "",
+		},
+	}
+
+	d := NewRayClusterDiscoverer(nil, false, nil, "")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := d.ExtractGangID(tt.pod); got != tt.want {
+				t.Errorf("ExtractGangID() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// --- DiscoverPeers tests ---
+
+func makeRayCluster(namespace, name string, workerReplicas ...int64) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(RayClusterGVK)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+
+	workerGroups := make([]any, 0, len(workerReplicas))
+	for _, replicas := range workerReplicas {
+		workerGroups = append(workerGroups, map[string]any{"groupName": "workers", "replicas": replicas})
+	}
+
+	_ = unstructured.SetNestedSlice(obj.Object, workerGroups, "spec", "workerGroupSpecs")
+
+	return obj
+}
+
+func makeRayPod(name, namespace, clusterName, ip string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    map[string]string{RayClusterLabel: clusterName},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   "node-1",
+			Containers: []corev1.Container{{Name: "c", Image: "img"}},
+		},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			PodIP: ip,
+		},
+	}
+}
+
+func TestRayClusterDiscoverer_DiscoverPeers(t *testing.T) {
+	pods := func() []runtime.Object {
+		return []runtime.Object{
+			makeRayPod("ray-head", "default", "raycluster-a", "10.0.0.1", corev1.PodRunning),
+			makeRayPod("ray-worker-0", "default", "raycluster-a", "10.0.0.2", corev1.PodRunning),
+			makeRayPod("ray-worker-1", "default", "raycluster-a", "10.0.0.3", corev1.PodPending),
+			makeRayPod("other-head", "default", "raycluster-b", "10.0.0.4", corev1.PodRunning),
+		}
+	}
+
+	t.Run("expected size counts head and worker group replicas", func(t *testing.T) {
+		objs := append(pods(), makeRayCluster("default", "raycluster-a", 2, 2))
+		c := fake.NewClientBuilder().WithRuntimeObjects(objs...).Build()
+		d := NewRayClusterDiscoverer(c, true, nil, "")
+
+		info, err := d.DiscoverPeers(context.Background(),
+			makeRayPod("ray-worker-0", "default", "raycluster-a", "10.0.0.2", corev1.PodRunning))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, "ray-default-raycluster-a", info.GangID)
+		assert.Len(t, info.Peers, 3)
+		assert.Equal(t, 5, info.ExpectedMinCount)
+	})
+
+	t.Run("without size lookup uses discovered peer count", func(t *testing.T) {
+		objs := append(pods(), makeRayCluster("default", "raycluster-a", 4))
+		c := fake.NewClientBuilder().WithRuntimeObjects(objs...).Build()
+		d := NewRayClusterDiscoverer(c, false, nil, "")
+
+		info, err := d.DiscoverPeers(context.Background(),
+			makeRayPod("ray-head", "default", "raycluster-a", "10.0.0.1", corev1.PodRunning))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, 3, info.ExpectedMinCount)
+	})
+
+	t.Run("missing RayCluster falls back to discovered peer count", func(t *testing.T) {
+		c := fake.NewClientBuilder().WithRuntimeObjects(pods()...).Build()
+		d := NewRayClusterDiscoverer(c, true, nil, "")
+
+		info, err := d.DiscoverPeers(context.Background(),
+			makeRayPod("ray-head", "default", "raycluster-a", "10.0.0.1", corev1.PodRunning))
+		require.NoError(t, err)
+		require.NotNil(t, info)
+		assert.Equal(t, 3, info.ExpectedMinCount)
+	})
+}
@@ -16,6 +16,7 @@
 package gang
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 
@@ -26,6 +27,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -36,6 +38,7 @@ type (
 	GangDiscoverer    = types.GangDiscoverer
 	Coordinator       = coordinator.Coordinator
 	CoordinatorConfig = coordinator.CoordinatorConfig
+	Topology          = coordinator.Topology
 )
 
 // Re-export coordinator functions.
@@ -45,20 +48,42 @@ var (
 	DefaultCoordinatorConfig = coordinator.DefaultCoordinatorConfig
 	ParsePeers               = coordinator.ParsePeers
 	GetRank                  = coordinator.GetRank
+	AssignTopology           = coordinator.AssignTopology
 )
 
+// Re-export type helpers.
+var DiffGangs = types.DiffGangs
+
 type discoveryType int
 
 const (
 	discoveryTypeInvalid discoveryType = iota
+	discoveryTypeUnset
 	discoveryTypeKubernetes
+	discoveryTypeJobSet
+	discoveryTypeRay
 	discoveryTypePodGroup
 )
 
+const (
+	// kubernetesDiscovererName selects the Kubernetes native Workload API discoverer explicitly.
+	kubernetesDiscovererName = "kubernetes"
+	// jobSetDiscovererName selects the JobSet discoverer.
+	jobSetDiscovererName = "jobset"
+	// rayDiscovererName selects the Ray cluster discoverer.
+	rayDiscovererName = "ray"
+)
+
 // NewDiscovererFromConfig creates a gang discoverer from configuration.
+// c is the cached reader, used by discoverers that select peers by label or index. apiReader reads
+// straight from the API server and is used to scan whole namespaces when no pod index is
+// available, since the cache cannot page through them. podCache is an optional informer cache
+// that discoverers index pods in to avoid listing whole namespaces; it must not have been started yet.
 func NewDiscovererFromConfig(
 	cfg config.GangDiscoveryConfig,
-	c client.Client,
+	c client.Reader,
+	apiReader client.Reader,
+	podCache cache.Cache,
 	restMapper meta.RESTMapper,
 ) (GangDiscoverer, error) {
 	switch detectDiscoveryType(cfg) {
@@ -67,7 +92,43 @@ func NewDiscovererFromConfig(
 			return nil, fmt.Errorf("kubernetes native Workload API not available (requires K8s 1.35+): %w", err)
 		}
 
-		return discoverer.NewWorkloadRefDiscoverer(c), nil
+		var podIndex client.Reader
+
+		if podCache != nil {
+			if err := discoverer.IndexPodsByWorkloadRef(context.Background(), podCache); err != nil {
+				return nil, err
+			}
+
+			podIndex = podCache
+		}
+
+		return discoverer.NewWorkloadRefDiscoverer(
+			apiReader, podIndex, cfg.PodPhases, discoverer.TerminatingPodPolicy(cfg.TerminatingPods),
+		), nil
+
+	case discoveryTypeJobSet:
+		if err := validateGVK(restMapper, discoverer.JobSetGVK); err != nil {
+			return nil, fmt.Errorf("JobSet API not available (is the JobSet controller installed?): %w", err)
+		}
+
+		return discoverer.NewJobSetDiscoverer(
+			c, cfg.PodPhases, discoverer.TerminatingPodPolicy(cfg.TerminatingPods),
+		), nil
+
+	case discoveryTypeRay:
+		// The RayCluster lookup only refines the expected gang size, so a missing
+		// KubeRay API falls back to the discovered pod count instead of failing.
+		lookupClusterSize := true
+		if err := validateGVK(restMapper, discoverer.RayClusterGVK); err != nil {
+			slog.Warn("RayCluster API not available, expected gang size will be the discovered pod count",
+				"error", err)
+
+			lookupClusterSize = false
+		}
+
+		return discoverer.NewRayClusterDiscoverer(
+			c, lookupClusterSize, cfg.PodPhases, discoverer.TerminatingPodPolicy(cfg.TerminatingPods),
+		), nil
 
 	case discoveryTypePodGroup:
 		gvr := schema.GroupVersionResource{
@@ -81,7 +142,14 @@ func NewDiscovererFromConfig(
 			return nil, fmt.Errorf("gangDiscovery.podGroupGVR validation failed: %w", err)
 		}
 
-		return newPodGroupDiscoverer(cfg, c, gvk)
+		return newPodGroupDiscoverer(cfg, c, apiReader, podCache, gvk)
+
+	case discoveryTypeUnset:
+		return nil, fmt.Errorf(
+			"gangDiscovery.requireExplicit is set but no discoverer is configured: set name %q for the "+
+				"native Workload API, %q for JobSet, %q for Ray, or configure a PodGroup-based discoverer",
+			kubernetesDiscovererName, jobSetDiscovererName, rayDiscovererName,
+		)
 
 	case discoveryTypeInvalid:
 		return nil, fmt.Errorf(
@@ -96,9 +164,25 @@ func NewDiscovererFromConfig(
 // detectDiscoveryType determines the discovery type from config.
 func detectDiscoveryType(cfg config.GangDiscoveryConfig) discoveryType {
 	if isEmptyConfig(cfg) {
+		if cfg.RequireExplicit {
+			return discoveryTypeUnset
+		}
+
 		return discoveryTypeKubernetes
 	}
 
+	if isNamedOnlyConfig(cfg, kubernetesDiscovererName) {
+		return discoveryTypeKubernetes
+	}
+
+	if isNamedOnlyConfig(cfg, jobSetDiscovererName) {
+		return discoveryTypeJobSet
+	}
+
+	if isNamedOnlyConfig(cfg, rayDiscovererName) {
+		return discoveryTypeRay
+	}
+
 	if isCompletePodGroupConfig(cfg) {
 		return discoveryTypePodGroup
 	}
@@ -116,6 +200,14 @@ func isEmptyConfig(cfg config.GangDiscoveryConfig) bool {
 		cfg.MinCountExpr == ""
 }
 
+// isNamedOnlyConfig reports whether the config names the given built-in discoverer and nothing else.
+func isNamedOnlyConfig(cfg config.GangDiscoveryConfig, name string) bool {
+	named := cfg
+	named.Name = ""
+
+	return cfg.Name == name && isEmptyConfig(named)
+}
+
 func isCompletePodGroupConfig(cfg config.GangDiscoveryConfig) bool {
 	hasName := cfg.Name != ""
 	hasKeys := len(cfg.AnnotationKeys) > 0 || len(cfg.LabelKeys) > 0
@@ -125,20 +217,39 @@ func isCompletePodGroupConfig(cfg config.GangDiscoveryConfig) bool {
 	return hasName && hasKeys && hasFullGVR && hasMinCountExpr
 }
 
+// newPodGroupDiscoverer reads PodGroups through the cached reader c and peers from a pod index on
+// podCache. Without a pod cache, it falls back to paging through the namespace with apiReader.
 func newPodGroupDiscoverer(
 	cfg config.GangDiscoveryConfig,
-	c client.Client,
+	c client.Reader,
+	apiReader client.Reader,
+	podCache cache.Cache,
 	gvk schema.GroupVersionKind,
 ) (GangDiscoverer, error) {
 	podGroupConfig := discoverer.PodGroupConfig{
-		Name:           cfg.Name,
-		AnnotationKeys: cfg.AnnotationKeys,
-		LabelKeys:      cfg.LabelKeys,
-		PodGroupGVK:    gvk,
-		MinCountExpr:   cfg.MinCountExpr,
+		Name:            cfg.Name,
+		AnnotationKeys:  cfg.AnnotationKeys,
+		LabelKeys:       cfg.LabelKeys,
+		PodGroupGVK:     gvk,
+		MinCountExpr:    cfg.MinCountExpr,
+		PodPhases:       cfg.PodPhases,
+		TerminatingPods: discoverer.TerminatingPodPolicy(cfg.TerminatingPods),
+	}
+
+	if podCache == nil {
+		return discoverer.NewPodGroupDiscoverer(apiReader, nil, podGroupConfig)
+	}
+
+	d, err := discoverer.NewPodGroupDiscoverer(c, podCache, podGroupConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.IndexPods(context.Background(), podCache); err != nil {
+		return nil, err
 	}
 
-	return discoverer.NewPodGroupDiscoverer(c, podGroupConfig)
+	return d, nil
 }
 
 // resolveGVK converts a GVR to GVK using the RESTMapper, validating the resource exists.
@@ -33,6 +33,8 @@ func TestNewDiscovererFromConfig(t *testing.T) {
 	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{
 		{Group: "scheduling.k8s.io", Version: "v1alpha1"},
 		{Group: "scheduling.volcano.sh", Version: "v1beta1"},
+		{Group: "jobset.x-k8s.io", Version: "v1alpha2"},
+		{Group: "ray.io", Version: "v1"},
 	})
 	// K8s 1.35+ native Workload API
 	restMapper.Add(schema.GroupVersionKind{
@@ -46,6 +48,18 @@ func TestNewDiscovererFromConfig(t *testing.T) {
 		Version: "v1beta1",
 		Kind:    "PodGroup",
 	}, meta.RESTScopeNamespace)
+	// JobSet
+	restMapper.Add(schema.GroupVersionKind{
+		Group:   "jobset.x-k8s.io",
+		Version: "v1alpha2",
+		Kind:    "JobSet",
+	}, meta.RESTScopeNamespace)
+	// KubeRay RayCluster
+	restMapper.Add(schema.GroupVersionKind{
+		Group:   "ray.io",
+		Version: "v1",
+		Kind:    "RayCluster",
+	}, meta.RESTScopeNamespace)
 
 	tests := []struct {
 		name      string
@@ -58,6 +72,51 @@ func TestNewDiscovererFromConfig(t *testing.T) {
 			cfg:      config.GangDiscoveryConfig{},
 			wantName: "kubernetes",
 		},
+		{
+			name:     "explicit kubernetes native",
+			cfg:      config.GangDiscoveryConfig{Name: "kubernetes"},
+			wantName: "kubernetes",
+		},
+		{
+			name:     "require explicit with kubernetes native named",
+			cfg:      config.GangDiscoveryConfig{Name: "kubernetes", RequireExplicit: true},
+			wantName: "kubernetes",
+		},
+		{
+			name:     "jobset",
+			cfg:      config.GangDiscoveryConfig{Name: "jobset"},
+			wantName: "jobset",
+		},
+		{
+			name:     "ray",
+			cfg:      config.GangDiscoveryConfig{Name: "ray"},
+			wantName: "ray",
+		},
+		{
+			name:     "require explicit with jobset named",
+			cfg:      config.GangDiscoveryConfig{Name: "jobset", RequireExplicit: true},
+			wantName: "jobset",
+		},
+		{
+			name:      "require explicit with empty config",
+			cfg:       config.GangDiscoveryConfig{RequireExplicit: true},
+			wantError: true,
+		},
+		{
+			name: "require explicit with PodGroup-based scheduler",
+			cfg: config.GangDiscoveryConfig{
+				Name:            "volcano",
+				AnnotationKeys:  []string{"volcano.sh/pod-group"},
+				MinCountExpr:    "podGroup.spec.minMember",
+				RequireExplicit: true,
+				PodGroupGVR: config.GVRConfig{
+					Group:    "scheduling.volcano.sh",
+					Version:  "v1beta1",
+					Resource: "podgroups",
+				},
+			},
+			wantName: "volcano",
+		},
 		{
 			name: "PodGroup-based scheduler",
 			cfg: config.GangDiscoveryConfig{
@@ -119,7 +178,7 @@ func TestNewDiscovererFromConfig(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := NewDiscovererFromConfig(tt.cfg, fakeClient, restMapper)
+			got, err := NewDiscovererFromConfig(tt.cfg, fakeClient, fakeClient, nil, restMapper)
 
 			if tt.wantError {
 				if err == nil {
@@ -139,3 +198,41 @@ func TestNewDiscovererFromConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestNewDiscovererFromConfigRequireExplicitWithoutWorkloadAPI(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().Build()
+	restMapper := meta.NewDefaultRESTMapper(nil)
+
+	cfg := config.GangDiscoveryConfig{Name: "kubernetes", RequireExplicit: true}
+
+	if _, err := NewDiscovererFromConfig(cfg, fakeClient, fakeClient, nil, restMapper); err == nil {
+		t.Error("NewDiscovererFromConfig() expected error when the Workload API is not available, got nil")
+	}
+}
+
+func TestNewDiscovererFromConfigJobSetWithoutJobSetAPI(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().Build()
+	restMapper := meta.NewDefaultRESTMapper(nil)
+
+	cfg := config.GangDiscoveryConfig{Name: "jobset"}
+
+	if _, err := NewDiscovererFromConfig(cfg, fakeClient, fakeClient, nil, restMapper); err == nil {
+		t.Error("NewDiscovererFromConfig() expected error when the JobSet API is not available, got nil")
+	}
+}
+
+func TestNewDiscovererFromConfigRayWithoutRayClusterAPI(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().Build()
+	restMapper := meta.NewDefaultRESTMapper(nil)
+
+	cfg := config.GangDiscoveryConfig{Name: "ray"}
+
+	got, err := NewDiscovererFromConfig(cfg, fakeClient, fakeClient, nil, restMapper)
+	if err != nil {
+		t.Fatalf("NewDiscovererFromConfig() error = %v, want fallback without the RayCluster API", err)
+	}
+
+	if got.Name() != "ray" {
+		t.Errorf("Discoverer.Name() = %q, want %q", got.Name(), "ray")
+	}
+}
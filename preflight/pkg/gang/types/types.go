@@ -17,6 +17,8 @@ package types
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 )
@@ -48,6 +50,14 @@ type GangInfo struct {
 
 	// Peers contains information about all discovered gang members.
 	Peers []PeerInfo
+
+	// ExcludedPeers contains discovered gang members that were left out of the
+	// active gang, e.g. because their node is quarantined or being drained.
+	ExcludedPeers []PeerInfo
+
+	// Ranks is the frozen rank assignment, keyed by pod name. It is empty until the
+	// coordinator freezes the ranks of a complete gang; until then ranks follow RankOrder.
+	Ranks map[string]int
 }
 
 // GangDiscoverer discovers all pods belonging to the same gang.
@@ -71,3 +81,118 @@ type GangDiscoverer interface {
 	// Returns nil GangInfo if the pod doesn't belong to a gang.
 	DiscoverPeers(ctx context.Context, pod *corev1.Pod) (*GangInfo, error)
 }
+
+// DiffGangs compares the membership of two discoveries of the same gang and returns
+// the peers present only in newGang (added) and only in oldGang (removed).
+// Peers are identified by pod name, so an IP change alone is not a membership change.
+// A nil GangInfo is treated as an empty gang.
+func DiffGangs(oldGang, newGang *GangInfo) (added, removed []PeerInfo) {
+	oldPeers := peersByName(oldGang)
+	newPeers := peersByName(newGang)
+
+	if newGang != nil {
+		for _, p := range newGang.Peers {
+			if _, ok := oldPeers[p.PodName]; !ok {
+				added = append(added, p)
+			}
+		}
+	}
+
+	if oldGang != nil {
+		for _, p := range oldGang.Peers {
+			if _, ok := newPeers[p.PodName]; !ok {
+				removed = append(removed, p)
+			}
+		}
+	}
+
+	return added, removed
+}
+
+// ExcludePeers moves the peers for which exclude returns true from Peers to ExcludedPeers
+// and lowers ExpectedMinCount accordingly, so the remaining peers can coordinate on their own.
+// It returns the peers excluded by this call.
+func ExcludePeers(gang *GangInfo, exclude func(PeerInfo) bool) []PeerInfo {
+	if gang == nil {
+		return nil
+	}
+
+	var (
+		active   []PeerInfo
+		excluded []PeerInfo
+	)
+
+	for _, p := range gang.Peers {
+		if exclude(p) {
+			excluded = append(excluded, p)
+		} else {
+			active = append(active, p)
+		}
+	}
+
+	if len(excluded) == 0 {
+		return nil
+	}
+
+	gang.Peers = active
+	gang.ExcludedPeers = append(gang.ExcludedPeers, excluded...)
+	gang.ExpectedMinCount = max(gang.ExpectedMinCount-len(excluded), len(active))
+
+	return excluded
+}
+
+// RankOrder returns the peers sorted by rank. Until ranks are frozen, a peer's rank is its
+// position in pod name order.
+func RankOrder(peers []PeerInfo) []PeerInfo {
+	sorted := slices.Clone(peers)
+	slices.SortFunc(sorted, func(a, b PeerInfo) int {
+		return strings.Compare(a.PodName, b.PodName)
+	})
+
+	return sorted
+}
+
+// MasterEndpoint returns the PodIP of the rank 0 peer, which collective frameworks use as
+// their rendezvous master address together with the coordinator's configured master port.
+// Frozen ranks take precedence over RankOrder. ok is false if the gang has no peers, or if
+// rank 0 is not among the peers or has no IP yet (still pending).
+func (g *GangInfo) MasterEndpoint() (ip string, ok bool) {
+	if g == nil || len(g.Peers) == 0 {
+		return "", false
+	}
+
+	var master PeerInfo
+
+	if len(g.Ranks) > 0 {
+		idx := slices.IndexFunc(g.Peers, func(p PeerInfo) bool {
+			rank, frozen := g.Ranks[p.PodName]
+			return frozen && rank == 0
+		})
+		if idx < 0 {
+			return "", false
+		}
+
+		master = g.Peers[idx]
+	} else {
+		master = RankOrder(g.Peers)[0]
+	}
+
+	if master.PodIP == "" {
+		return "", false
+	}
+
+	return master.PodIP, true
+}
+
+func peersByName(gang *GangInfo) map[string]PeerInfo {
+	if gang == nil {
+		return nil
+	}
+
+	peers := make(map[string]PeerInfo, len(gang.Peers))
+	for _, p := range gang.Peers {
+		peers[p.PodName] = p
+	}
+
+	return peers
+}
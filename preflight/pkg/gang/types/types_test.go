@@ -0,0 +1,201 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffGangs(t *testing.T) {
+	peerA := PeerInfo{PodName: "pod-a", PodIP: "10.0.0.1"}
+	peerB := PeerInfo{PodName: "pod-b", PodIP: "10.0.0.2"}
+	peerC := PeerInfo{PodName: "pod-c", PodIP: "10.0.0.3"}
+
+	tests := []struct {
+		name        string
+		oldGang     *GangInfo
+		newGang     *GangInfo
+		wantAdded   []PeerInfo
+		wantRemoved []PeerInfo
+	}{
+		{
+			name:      "peer added",
+			oldGang:   &GangInfo{Peers: []PeerInfo{peerA}},
+			newGang:   &GangInfo{Peers: []PeerInfo{peerA, peerB}},
+			wantAdded: []PeerInfo{peerB},
+		},
+		{
+			name:        "peer removed",
+			oldGang:     &GangInfo{Peers: []PeerInfo{peerA, peerB}},
+			newGang:     &GangInfo{Peers: []PeerInfo{peerA}},
+			wantRemoved: []PeerInfo{peerB},
+		},
+		{
+			name:        "peer replaced",
+			oldGang:     &GangInfo{Peers: []PeerInfo{peerA, peerB}},
+			newGang:     &GangInfo{Peers: []PeerInfo{peerA, peerC}},
+			wantAdded:   []PeerInfo{peerC},
+			wantRemoved: []PeerInfo{peerB},
+		},
+		{
+			name:    "stable membership",
+			oldGang: &GangInfo{Peers: []PeerInfo{peerA, peerB}},
+			newGang: &GangInfo{Peers: []PeerInfo{peerB, peerA}},
+		},
+		{
+			name:    "IP change is not a membership change",
+			oldGang: &GangInfo{Peers: []PeerInfo{peerA}},
+			newGang: &GangInfo{Peers: []PeerInfo{{PodName: "pod-a", PodIP: "10.0.0.99"}}},
+		},
+		{
+			name:      "nil old gang",
+			newGang:   &GangInfo{Peers: []PeerInfo{peerA}},
+			wantAdded: []PeerInfo{peerA},
+		},
+		{
+			name:        "nil new gang",
+			oldGang:     &GangInfo{Peers: []PeerInfo{peerA}},
+			wantRemoved: []PeerInfo{peerA},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed := DiffGangs(tt.oldGang, tt.newGang)
+			assert.Equal(t, tt.wantAdded, added)
+			assert.Equal(t, tt.wantRemoved, removed)
+		})
+	}
+}
+
+func TestExcludePeers(t *testing.T) {
+	peerA := PeerInfo{PodName: "pod-a", NodeName: "node-a"}
+	peerB := PeerInfo{PodName: "pod-b", NodeName: "node-b"}
+	peerC := PeerInfo{PodName: "pod-c", NodeName: "node-c"}
+
+	t.Run("excluded peers lower the expected count", func(t *testing.T) {
+		gang := &GangInfo{ExpectedMinCount: 3, Peers: []PeerInfo{peerA, peerB, peerC}}
+
+		excluded := ExcludePeers(gang, func(p PeerInfo) bool { return p.NodeName == "node-b" })
+
+		assert.Equal(t, []PeerInfo{peerB}, excluded)
+		assert.Equal(t, []PeerInfo{peerA, peerC}, gang.Peers)
+		assert.Equal(t, []PeerInfo{peerB}, gang.ExcludedPeers)
+		assert.Equal(t, 2, gang.ExpectedMinCount)
+	})
+
+	t.Run("no peers excluded leaves the gang unchanged", func(t *testing.T) {
+		gang := &GangInfo{ExpectedMinCount: 4, Peers: []PeerInfo{peerA, peerB}}
+
+		excluded := ExcludePeers(gang, func(PeerInfo) bool { return false })
+
+		assert.Empty(t, excluded)
+		assert.Equal(t, []PeerInfo{peerA, peerB}, gang.Peers)
+		assert.Equal(t, 4, gang.ExpectedMinCount)
+	})
+
+	t.Run("expected count never drops below the active peers", func(t *testing.T) {
+		gang := &GangInfo{ExpectedMinCount: 2, Peers: []PeerInfo{peerA, peerB, peerC}}
+
+		ExcludePeers(gang, func(p PeerInfo) bool { return p.PodName == "pod-a" })
+
+		assert.Equal(t, 2, gang.ExpectedMinCount)
+	})
+
+	t.Run("nil gang", func(t *testing.T) {
+		assert.Nil(t, ExcludePeers(nil, func(PeerInfo) bool { return true }))
+	})
+}
+
+func TestGangInfoMasterEndpoint(t *testing.T) {
+	tests := []struct {
+		name   string
+		gang   *GangInfo
+		wantIP string
+		wantOK bool
+	}{
+		{
+			name: "all peers assigned",
+			gang: &GangInfo{Peers: []PeerInfo{
+				{PodName: "worker-2", PodIP: "10.0.0.3"},
+				{PodName: "worker-0", PodIP: "10.0.0.1"},
+				{PodName: "worker-1", PodIP: "10.0.0.2"},
+			}},
+			wantIP: "10.0.0.1",
+			wantOK: true,
+		},
+		{
+			name: "IPv6 rank 0",
+			gang: &GangInfo{Peers: []PeerInfo{
+				{PodName: "worker-0", PodIP: "fd00::1"},
+			}},
+			wantIP: "fd00::1",
+			wantOK: true,
+		},
+		{
+			name: "rank 0 pending while others assigned",
+			gang: &GangInfo{Peers: []PeerInfo{
+				{PodName: "worker-1", PodIP: "10.0.0.2"},
+				{PodName: "worker-0"},
+				{PodName: "worker-2", PodIP: "10.0.0.3"},
+			}},
+		},
+		{
+			name: "rank 0 assigned while others pending",
+			gang: &GangInfo{Peers: []PeerInfo{
+				{PodName: "worker-1"},
+				{PodName: "worker-0", PodIP: "10.0.0.1"},
+			}},
+			wantIP: "10.0.0.1",
+			wantOK: true,
+		},
+		{
+			name: "frozen rank 0 takes precedence over pod name order",
+			gang: &GangInfo{
+				Peers: []PeerInfo{
+					{PodName: "worker-0", PodIP: "10.0.0.1"},
+					{PodName: "worker-1", PodIP: "10.0.0.2"},
+				},
+				Ranks: map[string]int{"worker-0": 1, "worker-1": 0},
+			},
+			wantIP: "10.0.0.2",
+			wantOK: true,
+		},
+		{
+			name: "frozen rank 0 is no longer a peer",
+			gang: &GangInfo{
+				Peers: []PeerInfo{{PodName: "worker-1", PodIP: "10.0.0.2"}},
+				Ranks: map[string]int{"worker-0": 0, "worker-1": 1},
+			},
+		},
+		{
+			name: "no peers",
+			gang: &GangInfo{},
+		},
+		{
+			name: "nil gang",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip, ok := tt.gang.MasterEndpoint()
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantIP, ip)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics defines Prometheus metrics exposed by preflight.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// Gang Coordination Metrics
+	GangConfigMapCreates = promauto.With(crmetrics.Registry).NewCounter(
+		prometheus.CounterOpts{
+			Name: "preflight_gang_configmap_creates_total",
+			Help: "Total number of gang ConfigMaps created.",
+		},
+	)
+	GangConfigMapUpdates = promauto.With(crmetrics.Registry).NewCounter(
+		prometheus.CounterOpts{
+			Name: "preflight_gang_configmap_updates_total",
+			Help: "Total number of successful gang ConfigMap updates.",
+		},
+	)
+	GangConfigMapConflicts = promauto.With(crmetrics.Registry).NewCounter(
+		prometheus.CounterOpts{
+			Name: "preflight_gang_configmap_conflicts_total",
+			Help: "Total number of gang ConfigMap updates rejected due to a conflicting concurrent write.",
+		},
+	)
+	GangRendezvousWaitDuration = promauto.With(crmetrics.Registry).NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "preflight_gang_rendezvous_wait_seconds",
+			Help:    "Time from gang ConfigMap creation until all expected peers are registered.",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+		},
+	)
+	GangStalePeersPruned = promauto.With(crmetrics.Registry).NewCounter(
+		prometheus.CounterOpts{
+			Name: "preflight_gang_stale_peers_pruned_total",
+			Help: "Total number of gang peers pruned because their heartbeat went stale before rendezvous.",
+		},
+	)
+	GangWorkloadLookupErrors = promauto.With(crmetrics.Registry).NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "preflight_gang_workload_lookup_errors_total",
+			Help: "Total number of failed Workload lookups during gang discovery, by reason.",
+		},
+		[]string{"reason"},
+	)
+)
+
+// Reasons for GangWorkloadLookupErrors.
+const (
+	WorkloadLookupReasonForbidden = "forbidden"
+	WorkloadLookupReasonNotFound  = "not_found"
+	WorkloadLookupReasonOther     = "other"
+)
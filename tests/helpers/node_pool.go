@@ -156,3 +156,95 @@ func annotateNodeAsUsed(ctx context.Context, client klient.Client, nodeName, tes
 
 	return client.Resources().Update(ctx, node)
 }
+
+// SelectTestNodesFromUnusedPool claims n distinct available nodes for the calling test.
+// The nodes are claimed together under the pool lock, so concurrent callers never receive
+// overlapping nodes, and they are released again when the test finishes. The test fails
+// immediately if fewer than n nodes are available.
+func SelectTestNodesFromUnusedPool(ctx context.Context, t *testing.T, client klient.Client, n int) []string {
+	t.Helper()
+
+	nodePoolMutex.Lock()
+	defer nodePoolMutex.Unlock()
+
+	nodes, err := GetAllNodesNames(ctx, client)
+	require.NoError(t, err, "failed to get nodes from cluster")
+
+	selected, err := pickAvailableNodes(nodes, n, func(nodeName string) bool {
+		return isNodeAvailable(ctx, t, client, nodeName, DefaultExpiry)
+	})
+	require.NoError(t, err)
+
+	claimed := make([]string, 0, len(selected))
+
+	for _, nodeName := range selected {
+		if err := annotateNodeAsUsed(ctx, client, nodeName, t.Name()); err != nil {
+			releaseNodesToPool(ctx, t, client, claimed)
+			require.NoError(t, err, "failed to annotate node %s as used", nodeName)
+		}
+
+		claimed = append(claimed, nodeName)
+	}
+
+	t.Cleanup(func() {
+		releaseCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		releaseNodesToPool(releaseCtx, t, client, claimed)
+	})
+
+	t.Logf("Acquired nodes %v for test '%s'", claimed, t.Name())
+
+	return claimed
+}
+
+// pickAvailableNodes returns the first n distinct nodes for which isAvailable returns true.
+func pickAvailableNodes(nodes []string, n int, isAvailable func(string) bool) ([]string, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("requested node count must be positive, got %d", n)
+	}
+
+	seen := make(map[string]struct{}, len(nodes))
+	selected := make([]string, 0, n)
+
+	for _, nodeName := range nodes {
+		if _, dup := seen[nodeName]; dup {
+			continue
+		}
+
+		seen[nodeName] = struct{}{}
+
+		if !isAvailable(nodeName) {
+			continue
+		}
+
+		selected = append(selected, nodeName)
+		if len(selected) == n {
+			return selected, nil
+		}
+	}
+
+	return nil, fmt.Errorf("requested %d unused nodes but only %d available out of %d nodes in the cluster",
+		n, len(selected), len(seen))
+}
+
+// releaseNodesToPool removes the usage annotations so the nodes can be claimed by other tests.
+func releaseNodesToPool(ctx context.Context, t *testing.T, client klient.Client, nodeNames []string) {
+	for _, nodeName := range nodeNames {
+		node, err := GetNodeByName(ctx, client, nodeName)
+		if err != nil {
+			t.Logf("Warning: failed to get node %s to release it: %v", nodeName, err)
+			continue
+		}
+
+		delete(node.Annotations, NodeUsedByAnnotation)
+		delete(node.Annotations, NodeUsedFromAnnotation)
+
+		if err := client.Resources().Update(ctx, node); err != nil {
+			t.Logf("Warning: failed to release node %s: %v", nodeName, err)
+			continue
+		}
+
+		t.Logf("Released node '%s' from test '%s'", nodeName, t.Name())
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2025, NVIDIA CORPORATION.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helpers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPickAvailableNodes_ReturnsDistinctNodes(t *testing.T) {
+	nodes := []string{"node-a", "node-b", "node-b", "node-c", "node-d"}
+	busy := map[string]bool{"node-c": true}
+
+	selected, err := pickAvailableNodes(nodes, 3, func(name string) bool { return !busy[name] })
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(selected) != 3 {
+		t.Fatalf("expected 3 nodes, got %d: %v", len(selected), selected)
+	}
+
+	seen := make(map[string]bool)
+
+	for _, name := range selected {
+		if seen[name] {
+			t.Errorf("node %s selected more than once: %v", name, selected)
+		}
+
+		if busy[name] {
+			t.Errorf("unavailable node %s was selected", name)
+		}
+
+		seen[name] = true
+	}
+}
+
+func TestPickAvailableNodes_NotEnoughNodes(t *testing.T) {
+	nodes := []string{"node-a", "node-b", "node-c"}
+
+	selected, err := pickAvailableNodes(nodes, 3, func(name string) bool { return name != "node-b" })
+	if err == nil {
+		t.Fatalf("expected error when fewer nodes are available, got %v", selected)
+	}
+
+	if !strings.Contains(err.Error(), "requested 3 unused nodes but only 2 available") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
+
+func TestPickAvailableNodes_InvalidCount(t *testing.T) {
+	if _, err := pickAvailableNodes([]string{"node-a"}, 0, func(string) bool { return true }); err == nil {
+		t.Error("expected error for non-positive node count")
+	}
+}